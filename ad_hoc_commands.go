@@ -0,0 +1,274 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Enum of frequently used ad hoc modules, any module installed in the execution environment works.
+const (
+	AdHocModuleCommand = "command"
+	AdHocModuleShell   = "shell"
+	AdHocModulePing    = "ping"
+	AdHocModuleSetup   = "setup"
+	AdHocModuleService = "service"
+)
+
+// AdHocCommandRequest represents the typed payload of an ad hoc command launch.
+// Zero values and nil pointers are not sent, so awx defaults are used for them.
+type AdHocCommandRequest struct {
+	// Inventory is required by Launch, LaunchOnInventory takes it from the path.
+	Inventory  int    `json:"inventory,omitempty"`
+	Credential int    `json:"credential"`
+	ModuleName string `json:"module_name,omitempty"`
+	ModuleArgs string `json:"module_args,omitempty"`
+	Limit      string `json:"limit,omitempty"`
+	// JobType is JobTypeRun or JobTypeCheck.
+	JobType   string `json:"job_type,omitempty"`
+	Forks     *int   `json:"forks,omitempty"`
+	Verbosity *int   `json:"verbosity,omitempty"`
+	// ExtraVars are sent as a json string.
+	ExtraVars            map[string]interface{} `json:"extra_vars,omitempty"`
+	BecomeEnabled        *bool                  `json:"become_enabled,omitempty"`
+	DiffMode             *bool                  `json:"diff_mode,omitempty"`
+	ExecutionEnvironment int                    `json:"execution_environment,omitempty"`
+}
+
+func (r *AdHocCommandRequest) validate() error {
+	if r.Credential == 0 {
+		return errors.New("mandatory input arguments are absent: [credential]")
+	}
+
+	return nil
+}
+
+// MarshalJSON renders extra vars as a json string, awx stores them as text.
+func (r *AdHocCommandRequest) MarshalJSON() ([]byte, error) {
+	type adHocCommandRequest AdHocCommandRequest
+	payload := struct {
+		*adHocCommandRequest
+		ExtraVars string `json:"extra_vars,omitempty"`
+	}{
+		adHocCommandRequest: (*adHocCommandRequest)(r),
+	}
+
+	if r.ExtraVars != nil {
+		rendered, err := json.Marshal(r.ExtraVars)
+		if err != nil {
+			return nil, fmt.Errorf("extra vars: %w", err)
+		}
+		payload.ExtraVars = string(rendered)
+	}
+
+	return json.Marshal(payload)
+}
+
+// AdHocCommandService implements awx ad hoc command apis.
+type AdHocCommandService struct {
+	Requester *Requester
+}
+
+// ListAdHocCommands shows a list of ad hoc commands.
+func (a *AdHocCommandService) ListAdHocCommands(ctx context.Context, params ListParams) (*ListAdHocCommands, error) {
+	result := ListAdHocCommands{}
+	endpoint := "/api/v2/ad_hoc_commands/"
+
+	_, err := a.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// AdHocCommandsIter returns an iterator over all ad hoc commands, following pagination.
+func (a *AdHocCommandService) AdHocCommandsIter(params ListParams, opts *ListOptions) *Iterator[*AdHocCommand] {
+	return newIterator[*AdHocCommand](a.Requester, "/api/v2/ad_hoc_commands/", params, opts)
+}
+
+// GetAdHocCommand shows the details of an ad hoc command.
+func (a *AdHocCommandService) GetAdHocCommand(ctx context.Context, id int) (*AdHocCommand, error) {
+	result := AdHocCommand{}
+	endpoint := fmt.Sprintf("/api/v2/ad_hoc_commands/%d/", id)
+
+	_, err := a.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Launch runs an ad hoc command.
+//
+//	command, err := client.AdHocCommandService.Launch(ctx, &awx.AdHocCommandRequest{
+//		Inventory:  1,
+//		Credential: 2,
+//		ModuleName: awx.AdHocModuleShell,
+//		ModuleArgs: "uptime",
+//		Limit:      "web",
+//	})
+func (a *AdHocCommandService) Launch(ctx context.Context, request *AdHocCommandRequest) (*AdHocCommand, error) {
+	result := AdHocCommand{}
+	endpoint := "/api/v2/ad_hoc_commands/"
+
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+	if request.Inventory == 0 {
+		return nil, errors.New("mandatory input arguments are absent: [inventory]")
+	}
+
+	_, err := a.Requester.Post(ctx, endpoint, request, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// LaunchOnInventory runs an ad hoc command on the hosts of an inventory.
+func (a *AdHocCommandService) LaunchOnInventory(ctx context.Context, inventoryID int, request *AdHocCommandRequest) (*AdHocCommand, error) {
+	result := AdHocCommand{}
+	endpoint := fmt.Sprintf("/api/v2/inventories/%d/ad_hoc_commands/", inventoryID)
+
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+
+	_, err := a.Requester.Post(ctx, endpoint, request, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CancelAdHocCommand cancels an ad hoc command.
+func (a *AdHocCommandService) CancelAdHocCommand(ctx context.Context, id int) (*CancelJobResponse, error) {
+	result := CancelJobResponse{}
+	endpoint := fmt.Sprintf("/api/v2/ad_hoc_commands/%d/cancel/", id)
+
+	_, err := a.Requester.Post(ctx, endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RelaunchAdHocCommand runs the ad hoc command again and returns the new one.
+//
+//	credential_passwords JSON
+func (a *AdHocCommandService) RelaunchAdHocCommand(ctx context.Context, id int, data map[string]interface{}) (*AdHocCommand, error) {
+	result := AdHocCommand{}
+	endpoint := fmt.Sprintf("/api/v2/ad_hoc_commands/%d/relaunch/", id)
+
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	_, err := a.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetAdHocCommandEvents shows the events of an ad hoc command.
+func (a *AdHocCommandService) GetAdHocCommandEvents(ctx context.Context, id int, params ListParams) (*JobEvents, error) {
+	result := JobEvents{}
+	endpoint := fmt.Sprintf("/api/v2/ad_hoc_commands/%d/events/", id)
+
+	_, err := a.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// StreamEvents follows the events of an ad hoc command in real time,
+// with the same semantics as JobService.StreamJobEvents.
+func (a *AdHocCommandService) StreamEvents(ctx context.Context, id int, filter *JobEventFilter) (<-chan JobEvent, <-chan error) {
+	events := make(chan JobEvent)
+	errs := make(chan error, 1)
+	endpoint := fmt.Sprintf("/api/v2/ad_hoc_commands/%d/events/", id)
+
+	go func() {
+		defer close(errs)
+		defer close(events)
+
+		err := streamEvents(ctx, a.Requester, endpoint, filter, events, func(ctx context.Context) (string, bool, error) {
+			command, err := a.GetAdHocCommand(ctx, id)
+			if err != nil {
+				return "", false, err
+			}
+
+			return command.Status, command.EventProcessingFinished, nil
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return events, errs
+}
+
+// Wait polls the ad hoc command until it reaches one of the finished statuses.
+// A *JobFailedError is returned along with the command when it is failed, error or canceled.
+// The wait is bounded by ctx only, use context.WithTimeout to limit it.
+func (a *AdHocCommandService) Wait(ctx context.Context, id int, opts *WaitOptions) (*AdHocCommand, error) {
+	return waitFor(ctx, id, opts, func(ctx context.Context) (*AdHocCommand, jobState, error) {
+		command, err := a.GetAdHocCommand(ctx, id)
+		if err != nil {
+			return nil, jobState{}, err
+		}
+
+		return command, jobState{
+			Type:            "ad_hoc_command",
+			Status:          command.Status,
+			JobExplanation:  command.JobExplanation,
+			ResultTraceback: command.ResultTraceback,
+		}, nil
+	})
+}
+
+// GetAdHocCommandStdout shows the output of an ad hoc command in txt, ansi, json or html format.
+func (a *AdHocCommandService) GetAdHocCommandStdout(ctx context.Context, id int, format string, opts *StdoutOptions) ([]byte, error) {
+	endpoint := fmt.Sprintf("/api/v2/ad_hoc_commands/%d/stdout/", id)
+	return getStdout(ctx, a.Requester, endpoint, format, opts)
+}
+
+// GetAdHocCommandStdoutJSON shows the output of an ad hoc command along with the range of returned lines.
+func (a *AdHocCommandService) GetAdHocCommandStdoutJSON(ctx context.Context, id int, opts *StdoutOptions) (*Stdout, error) {
+	endpoint := fmt.Sprintf("/api/v2/ad_hoc_commands/%d/stdout/", id)
+	return getStdoutJSON(ctx, a.Requester, endpoint, opts)
+}
+
+// FollowStdout writes the output of an ad hoc command into w while it is running
+// and returns the command once it finished and the whole output is written.
+// The command status is not treated as an error, check it on the returned command.
+func (a *AdHocCommandService) FollowStdout(ctx context.Context, id int, w io.Writer, opts *FollowOptions) (*AdHocCommand, error) {
+	var command *AdHocCommand
+	endpoint := fmt.Sprintf("/api/v2/ad_hoc_commands/%d/stdout/", id)
+
+	err := followStdout(ctx, a.Requester, endpoint, w, opts, func(ctx context.Context) (string, bool, error) {
+		var err error
+		command, err = a.GetAdHocCommand(ctx, id)
+		if err != nil {
+			return "", false, err
+		}
+
+		return command.Status, command.EventProcessingFinished, nil
+	})
+	if err != nil {
+		return command, err
+	}
+
+	return command, nil
+}
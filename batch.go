@@ -0,0 +1,206 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultBatchWorkers is the number of concurrent workers of RunBatch by default.
+const DefaultBatchWorkers = 4
+
+// BatchOptions configures RunBatch.
+type BatchOptions struct {
+	// Workers is the number of items processed concurrently, DefaultBatchWorkers by default.
+	Workers int
+	// RateLimit is the maximum number of items started per second, zero means no limit.
+	RateLimit float64
+	// Burst is the number of items that may start at once within RateLimit, 1 by default.
+	Burst int
+	// StopOnError cancels the remaining items after the first failure.
+	StopOnError bool
+}
+
+// BatchItemResult is the outcome of one item of a batch.
+type BatchItemResult[I any, T any] struct {
+	// Index is the position of the item in the input.
+	Index int
+	Item  I
+	Value T
+	Err   error
+}
+
+// BatchReport is the aggregated result of RunBatch.
+// Items which were not started because of cancellation are reported as failed with the context error.
+type BatchReport[I any, T any] struct {
+	Succeeded []*BatchItemResult[I, T]
+	Failed    []*BatchItemResult[I, T]
+}
+
+// APIErrors returns the awx api errors of the failed items by their index.
+func (r *BatchReport[I, T]) APIErrors() map[int]*APIError {
+	result := map[int]*APIError{}
+	for _, item := range r.Failed {
+		if apiErr, ok := AsAPIError(item.Err); ok {
+			result[item.Index] = apiErr
+		}
+	}
+
+	return result
+}
+
+// Err returns a *BatchError when any item failed, nil otherwise.
+func (r *BatchReport[I, T]) Err() error {
+	if len(r.Failed) == 0 {
+		return nil
+	}
+
+	batchErr := &BatchError{
+		Total:  len(r.Succeeded) + len(r.Failed),
+		Errors: map[int]error{},
+	}
+	for _, item := range r.Failed {
+		batchErr.Errors[item.Index] = item.Err
+	}
+
+	return batchErr
+}
+
+// BatchError lists the errors of the failed items of a batch by their index.
+type BatchError struct {
+	Total  int
+	Errors map[int]error
+}
+
+func (e *BatchError) Error() string {
+	indexes := make([]int, 0, len(e.Errors))
+	for index := range e.Errors {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+
+	parts := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		parts = append(parts, fmt.Sprintf("item %d: %v", index, e.Errors[index]))
+	}
+
+	return fmt.Sprintf("%d of %d batch items failed: %s", len(e.Errors), e.Total, strings.Join(parts, "; "))
+}
+
+// RunBatch calls fn for every item using a bounded pool of workers.
+// Results are sorted by the index of their items.
+//
+//	report := awx.RunBatch(ctx, ids, &awx.BatchOptions{Workers: 8, RateLimit: 20},
+//		func(ctx context.Context, id int) (struct{}, error) {
+//			return struct{}{}, client.HostService.DeleteHost(ctx, id)
+//		})
+//	if err := report.Err(); err != nil {
+//		...
+//	}
+func RunBatch[I any, T any](ctx context.Context, items []I, opts *BatchOptions, fn func(ctx context.Context, item I) (T, error)) *BatchReport[I, T] {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = DefaultBatchWorkers
+	}
+	if workers > len(items) {
+		workers = len(items)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	limiter := newTokenBucket(opts.RateLimit, opts.Burst)
+	results := make([]*BatchItemResult[I, T], len(items))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for index := range indexes {
+				result := &BatchItemResult[I, T]{Index: index, Item: items[index]}
+				results[index] = result
+
+				if err := limiter.Wait(ctx); err != nil {
+					result.Err = err
+					continue
+				}
+
+				result.Value, result.Err = fn(ctx, items[index])
+				if result.Err != nil && opts.StopOnError {
+					cancel()
+				}
+			}
+		}()
+	}
+
+	for index := range items {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	report := &BatchReport[I, T]{
+		Succeeded: make([]*BatchItemResult[I, T], 0, len(items)),
+		Failed:    make([]*BatchItemResult[I, T], 0),
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			report.Failed = append(report.Failed, result)
+		} else {
+			report.Succeeded = append(report.Succeeded, result)
+		}
+	}
+
+	return report
+}
+
+// HostUpdate is an update of one host applied by BatchUpdateHosts.
+type HostUpdate struct {
+	ID   int
+	Data map[string]interface{}
+}
+
+// HostGroupAssociation is a host added to a group by BatchAssociateGroup.
+type HostGroupAssociation struct {
+	HostID  int
+	GroupID int
+}
+
+// BatchCreateHosts creates the hosts concurrently, see CreateHost for the fields.
+func (h *HostService) BatchCreateHosts(ctx context.Context, hosts []map[string]interface{}, opts *BatchOptions) *BatchReport[map[string]interface{}, *Host] {
+	return RunBatch(ctx, hosts, opts, func(ctx context.Context, data map[string]interface{}) (*Host, error) {
+		return h.CreateHost(ctx, data)
+	})
+}
+
+// BatchUpdateHosts updates the hosts concurrently.
+func (h *HostService) BatchUpdateHosts(ctx context.Context, updates []HostUpdate, opts *BatchOptions) *BatchReport[HostUpdate, *Host] {
+	return RunBatch(ctx, updates, opts, func(ctx context.Context, update HostUpdate) (*Host, error) {
+		return h.UpdateHost(ctx, update.ID, update.Data)
+	})
+}
+
+// BatchAssociateGroup adds the hosts to the groups concurrently.
+func (h *HostService) BatchAssociateGroup(ctx context.Context, associations []HostGroupAssociation, opts *BatchOptions) *BatchReport[HostGroupAssociation, *Host] {
+	return RunBatch(ctx, associations, opts, func(ctx context.Context, association HostGroupAssociation) (*Host, error) {
+		return h.AssociateGroup(ctx, association.HostID, map[string]interface{}{
+			"id": association.GroupID,
+		})
+	})
+}
+
+// BatchDeleteHosts deletes the hosts concurrently.
+func (h *HostService) BatchDeleteHosts(ctx context.Context, ids []int, opts *BatchOptions) *BatchReport[int, struct{}] {
+	return RunBatch(ctx, ids, opts, func(ctx context.Context, id int) (struct{}, error) {
+		return struct{}{}, h.DeleteHost(ctx, id)
+	})
+}
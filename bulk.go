@@ -0,0 +1,311 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// DefaultBulkChunkSize is the default number of hosts or jobs awx accepts in one bulk request,
+// see BULK_HOST_MAX_CREATE and BULK_JOB_MAX_LAUNCH settings.
+const DefaultBulkChunkSize = 100
+
+// BulkService implements awx bulk apis available since AWX 22.
+type BulkService struct {
+	Requester *Requester
+}
+
+// BulkOptions configures the bulk requests.
+type BulkOptions struct {
+	// ChunkSize is the number of items sent in one request, DefaultBulkChunkSize by default.
+	// It must not exceed the server limit.
+	ChunkSize int
+	// ContinueOnError sends the remaining chunks when one fails.
+	ContinueOnError bool
+	// Wait waits for the workflow jobs created by BulkLaunchJobs and reports the status of every job.
+	Wait bool
+	// WaitOptions configures the polling of Wait.
+	WaitOptions *WaitOptions
+}
+
+func (o *BulkOptions) chunkSize() int {
+	if o == nil || o.ChunkSize <= 0 {
+		return DefaultBulkChunkSize
+	}
+
+	return o.ChunkSize
+}
+
+// BulkHost is a host created by BulkCreateHosts.
+type BulkHost struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+	InstanceID  string `json:"instance_id,omitempty"`
+	// Variables are yaml or json.
+	Variables string `json:"variables,omitempty"`
+}
+
+// BulkHostFailure is a host which creation failed along with the error of its chunk.
+type BulkHostFailure struct {
+	Host BulkHost
+	Err  error
+}
+
+// BulkHostsReport is the result of BulkCreateHosts.
+type BulkHostsReport struct {
+	Created []*Host
+	Failed  []*BulkHostFailure
+}
+
+// BulkCreateHosts creates the hosts in the inventory, sending them in chunks.
+// Every chunk is created by awx atomically, so a failed chunk reports all its hosts as failed.
+// The report is returned along with the error of the first failed chunk.
+func (b *BulkService) BulkCreateHosts(ctx context.Context, inventoryID int, hosts []BulkHost, opts *BulkOptions) (*BulkHostsReport, error) {
+	report := &BulkHostsReport{
+		Created: make([]*Host, 0, len(hosts)),
+		Failed:  make([]*BulkHostFailure, 0),
+	}
+
+	var firstErr error
+	size := opts.chunkSize()
+	for start := 0; start < len(hosts); start += size {
+		end := start + size
+		if end > len(hosts) {
+			end = len(hosts)
+		}
+		chunk := hosts[start:end]
+
+		result, err := b.createHosts(ctx, inventoryID, chunk)
+		if err != nil {
+			for _, host := range chunk {
+				report.Failed = append(report.Failed, &BulkHostFailure{Host: host, Err: err})
+			}
+
+			if firstErr == nil {
+				firstErr = fmt.Errorf("bulk host create of hosts %d-%d: %w", start, end-1, err)
+			}
+			if opts == nil || !opts.ContinueOnError || ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+
+		report.Created = append(report.Created, result.Hosts...)
+	}
+
+	return report, firstErr
+}
+
+func (b *BulkService) createHosts(ctx context.Context, inventoryID int, hosts []BulkHost) (*BulkHostCreateResponse, error) {
+	result := BulkHostCreateResponse{}
+	endpoint := "/api/v2/bulk/host_create/"
+
+	payload := map[string]interface{}{
+		"inventory": inventoryID,
+		"hosts":     hosts,
+	}
+
+	_, err := b.Requester.Post(ctx, endpoint, payload, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// BulkJob is a job launched by BulkLaunchJobs, zero values keep the template defaults.
+type BulkJob struct {
+	UnifiedJobTemplate   int                    `json:"unified_job_template"`
+	Identifier           string                 `json:"identifier,omitempty"`
+	Inventory            int                    `json:"inventory,omitempty"`
+	Limit                string                 `json:"limit,omitempty"`
+	ScmBranch            string                 `json:"scm_branch,omitempty"`
+	JobType              string                 `json:"job_type,omitempty"`
+	JobTags              string                 `json:"job_tags,omitempty"`
+	SkipTags             string                 `json:"skip_tags,omitempty"`
+	ExtraData            map[string]interface{} `json:"extra_data,omitempty"`
+	Verbosity            *int                   `json:"verbosity,omitempty"`
+	DiffMode             *bool                  `json:"diff_mode,omitempty"`
+	Credentials          []int                  `json:"credentials,omitempty"`
+	Labels               []int                  `json:"labels,omitempty"`
+	InstanceGroups       []int                  `json:"instance_groups,omitempty"`
+	ExecutionEnvironment int                    `json:"execution_environment,omitempty"`
+	Forks                *int                   `json:"forks,omitempty"`
+	Timeout              *int                   `json:"timeout,omitempty"`
+	JobSliceCount        *int                   `json:"job_slice_count,omitempty"`
+}
+
+// BulkJobLaunchRequest represents the payload of BulkLaunchJobs.
+// AWX runs the jobs as nodes of a new workflow job, one per chunk.
+type BulkJobLaunchRequest struct {
+	Name         string                 `json:"name,omitempty"`
+	Description  string                 `json:"description,omitempty"`
+	Jobs         []BulkJob              `json:"jobs"`
+	Organization int                    `json:"organization,omitempty"`
+	Inventory    int                    `json:"inventory,omitempty"`
+	Limit        string                 `json:"limit,omitempty"`
+	ScmBranch    string                 `json:"scm_branch,omitempty"`
+	SkipTags     string                 `json:"skip_tags,omitempty"`
+	JobTags      string                 `json:"job_tags,omitempty"`
+	ExtraVars    map[string]interface{} `json:"extra_vars,omitempty"`
+}
+
+// BulkJobResult is the outcome of one job of BulkLaunchJobs.
+type BulkJobResult struct {
+	// Request is the job as it was requested.
+	Request BulkJob
+	// WorkflowJob is the ID of the workflow job running the job, zero when its chunk failed.
+	WorkflowJob int
+	// Job is the ID of the spawned job, known only with BulkOptions.Wait.
+	Job    int
+	Status string
+	Failed bool
+	Err    error
+}
+
+// BulkJobsReport is the result of BulkLaunchJobs.
+type BulkJobsReport struct {
+	WorkflowJobs []*WorkflowJob
+	Jobs         []*BulkJobResult
+}
+
+// BulkLaunchJobs launches the jobs, sending them in chunks.
+// With BulkOptions.Wait the workflow jobs are awaited and every result gets the status of its job.
+// The report is returned along with the first error.
+func (b *BulkService) BulkLaunchJobs(ctx context.Context, request *BulkJobLaunchRequest, opts *BulkOptions) (*BulkJobsReport, error) {
+	report := &BulkJobsReport{
+		WorkflowJobs: make([]*WorkflowJob, 0),
+		Jobs:         make([]*BulkJobResult, 0, len(request.Jobs)),
+	}
+
+	var firstErr error
+	size := opts.chunkSize()
+	for start := 0; start < len(request.Jobs); start += size {
+		end := start + size
+		if end > len(request.Jobs) {
+			end = len(request.Jobs)
+		}
+
+		chunk := *request
+		chunk.Jobs = request.Jobs[start:end]
+
+		workflowJob, err := b.launchJobs(ctx, &chunk)
+		for _, job := range chunk.Jobs {
+			result := &BulkJobResult{Request: job, Err: err}
+			if workflowJob != nil {
+				result.WorkflowJob = workflowJob.ID
+				result.Status = workflowJob.Status
+			}
+			report.Jobs = append(report.Jobs, result)
+		}
+
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("bulk job launch of jobs %d-%d: %w", start, end-1, err)
+			}
+			if opts == nil || !opts.ContinueOnError || ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+
+		report.WorkflowJobs = append(report.WorkflowJobs, workflowJob)
+	}
+
+	if opts != nil && opts.Wait {
+		if err := b.waitJobs(ctx, report, opts.WaitOptions); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return report, firstErr
+}
+
+func (b *BulkService) launchJobs(ctx context.Context, request *BulkJobLaunchRequest) (*WorkflowJob, error) {
+	result := WorkflowJob{}
+	endpoint := "/api/v2/bulk/job_launch/"
+
+	_, err := b.Requester.Post(ctx, endpoint, request, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// waitJobs waits for every launched workflow job and fills the results from its nodes.
+// Jobs are matched to the nodes by Identifier, jobs without one take the remaining
+// nodes in the order they were created.
+func (b *BulkService) waitJobs(ctx context.Context, report *BulkJobsReport, opts *WaitOptions) error {
+	workflowJobs := &WorkflowJobService{Requester: b.Requester}
+
+	for i, workflowJob := range report.WorkflowJobs {
+		finished, err := workflowJobs.Wait(ctx, workflowJob.ID, opts)
+
+		// A failed job fails the workflow job, it is reported per job below.
+		var failed *JobFailedError
+		if err != nil && !errors.As(err, &failed) {
+			return err
+		}
+		report.WorkflowJobs[i] = finished
+
+		nodes, err := workflowJobs.ListAllWorkflowNodes(ctx, workflowJob.ID, map[string]string{})
+		if err != nil {
+			return err
+		}
+		sort.Slice(nodes, func(x, y int) bool {
+			return nodes[x].ID < nodes[y].ID
+		})
+
+		byIdentifier := make(map[string]*WorkflowJobNode, len(nodes))
+		for _, node := range nodes {
+			byIdentifier[node.Identifier] = node
+		}
+
+		matched := map[int]bool{}
+		positional := make([]*BulkJobResult, 0)
+		for _, result := range report.Jobs {
+			if result.WorkflowJob != workflowJob.ID {
+				continue
+			}
+
+			if result.Request.Identifier == "" {
+				positional = append(positional, result)
+				continue
+			}
+
+			node, ok := byIdentifier[result.Request.Identifier]
+			if !ok {
+				result.Err = fmt.Errorf("workflow job %d has no node %q", workflowJob.ID, result.Request.Identifier)
+				continue
+			}
+			matched[node.ID] = true
+			result.fill(node)
+		}
+
+		remaining := make([]*WorkflowJobNode, 0, len(nodes))
+		for _, node := range nodes {
+			if !matched[node.ID] {
+				remaining = append(remaining, node)
+			}
+		}
+
+		for n, result := range positional {
+			if n >= len(remaining) {
+				result.Err = fmt.Errorf("workflow job %d has no node left for the job", workflowJob.ID)
+				continue
+			}
+			result.fill(remaining[n])
+		}
+	}
+
+	return nil
+}
+
+func (r *BulkJobResult) fill(node *WorkflowJobNode) {
+	r.Job = node.Job
+	r.Status = node.JobStatus()
+	r.Failed = node.Failed()
+}
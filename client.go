@@ -2,86 +2,139 @@ package awx
 
 import (
 	"net/http"
+	"strings"
 )
 
 type Client struct {
-	JobTemplateService   *JobTemplateService
-	InventoriesService   *InventoriesService
-	HostService          *HostService
-	JobService           *JobService
-	OrganizationsService *OrganizationsService
-	GroupService         *GroupService
+	JobTemplateService         *JobTemplateService
+	InventoriesService         *InventoriesService
+	HostService                *HostService
+	JobService                 *JobService
+	OrganizationsService       *OrganizationsService
+	GroupService               *GroupService
+	ProjectService             *ProjectService
+	CredentialService          *CredentialService
+	WorkflowJobTemplateService *WorkflowJobTemplateService
+	WorkflowJobService         *WorkflowJobService
+	WorkflowApprovalService    *WorkflowApprovalService
+	ScheduleService            *ScheduleService
+	UserService                *UserService
+	TeamService                *TeamService
+	RoleService                *RoleService
+	BulkService                *BulkService
+	InventorySourceService     *InventorySourceService
+	InventoryUpdateService     *InventoryUpdateService
+	AdHocCommandService        *AdHocCommandService
+	UnifiedJobService          *UnifiedJobService
 }
 
-func NewClient(baseURL string, username string, password string) (*Client, error) {
+// New creates an awx client configured by options.
+//
+//	client, err := awx.New("https://awx.example.com",
+//		awx.WithToken(token),
+//		awx.WithTimeout(30*time.Second),
+//	)
+func New(baseURL string, opts ...Option) (*Client, error) {
+	config := &clientConfig{
+		headers: http.Header{},
+	}
 
-	tokenAuth := BasicAuth{
-		Username: username,
-		Password: password,
+	for _, opt := range opts {
+		if err := opt(config); err != nil {
+			return nil, err
+		}
+	}
+
+	httpClient, err := config.buildHTTPClient()
+	if err != nil {
+		return nil, err
 	}
 
 	requester := Requester{
-		Base:   baseURL,
-		Auth:   &tokenAuth,
-		Client: http.DefaultClient,
+		Base:    strings.TrimSuffix(baseURL, "/"),
+		Auth:    config.auth,
+		Client:  httpClient,
+		Retry:   config.retry,
+		Headers: config.headers,
+		Limiter: config.buildLimiter(),
 	}
 
+	return newClient(&requester), nil
+}
+
+// NewClient creates an awx client with basic auth.
+func NewClient(baseURL string, username string, password string) (*Client, error) {
+	return New(baseURL, WithBasicAuth(username, password))
+}
+
+// NewClientWithToken creates an awx client with token auth.
+func NewClientWithToken(baseURL string, token string) (*Client, error) {
+	return New(baseURL, WithToken(token))
+}
+
+func newClient(requester *Requester) *Client {
 	client := Client{
 		JobTemplateService: &JobTemplateService{
-			Requester: &requester,
+			Requester: requester,
 		},
 		InventoriesService: &InventoriesService{
-			Requester: &requester,
+			Requester: requester,
 		},
 		HostService: &HostService{
-			Requester: &requester,
+			Requester: requester,
 		},
 		JobService: &JobService{
-			Requester: &requester,
+			Requester: requester,
 		},
 		OrganizationsService: &OrganizationsService{
-			Requester: &requester,
+			Requester: requester,
 		},
 		GroupService: &GroupService{
-			Requester: &requester,
+			Requester: requester,
 		},
-	}
-
-	return &client, nil
-}
-
-func NewClientWithToken(baseURL string, token string) (*Client, error) {
-
-	tokenAuth := TokenAuth{
-		Token: token,
-	}
-
-	requester := Requester{
-		Base:   baseURL,
-		Auth:   &tokenAuth,
-		Client: http.DefaultClient,
-	}
-
-	client := Client{
-		JobTemplateService: &JobTemplateService{
-			Requester: &requester,
+		ProjectService: &ProjectService{
+			Requester: requester,
 		},
-		InventoriesService: &InventoriesService{
-			Requester: &requester,
+		CredentialService: &CredentialService{
+			Requester: requester,
 		},
-		HostService: &HostService{
-			Requester: &requester,
+		WorkflowJobTemplateService: &WorkflowJobTemplateService{
+			Requester: requester,
 		},
-		JobService: &JobService{
-			Requester: &requester,
+		WorkflowJobService: &WorkflowJobService{
+			Requester: requester,
 		},
-		OrganizationsService: &OrganizationsService{
-			Requester: &requester,
+		WorkflowApprovalService: &WorkflowApprovalService{
+			Requester: requester,
 		},
-		GroupService: &GroupService{
-			Requester: &requester,
+		ScheduleService: &ScheduleService{
+			Requester: requester,
+		},
+		UserService: &UserService{
+			Requester: requester,
+		},
+		TeamService: &TeamService{
+			Requester: requester,
+		},
+		RoleService: &RoleService{
+			Requester: requester,
+		},
+		BulkService: &BulkService{
+			Requester: requester,
+		},
+		InventorySourceService: &InventorySourceService{
+			Requester: requester,
+		},
+		InventoryUpdateService: &InventoryUpdateService{
+			Requester: requester,
+		},
+		AdHocCommandService: &AdHocCommandService{
+			Requester: requester,
+		},
+		UnifiedJobService: &UnifiedJobService{
+			Requester: requester,
 		},
 	}
 
-	return &client, nil
+	return &client
 }
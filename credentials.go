@@ -0,0 +1,414 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// redacted replaces credential input values in String output.
+const redacted = "<redacted>"
+
+// CredentialInputs holds the inputs of a credential, like username, password or ssh_key_data.
+// Values are kept out of String and GoString, so a credential can be logged
+// without leaking secrets. They are still sent to the api as is.
+type CredentialInputs map[string]interface{}
+
+// String lists input names with redacted values.
+func (in CredentialInputs) String() string {
+	keys := make([]string, 0, len(in))
+	for key := range in {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, key+":"+redacted)
+	}
+
+	return "map[" + strings.Join(parts, " ") + "]"
+}
+
+// GoString keeps values out of %#v output.
+func (in CredentialInputs) GoString() string {
+	return "awx.CredentialInputs" + in.String()
+}
+
+// String describes the credential without its inputs values.
+func (c *Credential) String() string {
+	return fmt.Sprintf("Credential{ID:%d Name:%q Kind:%q CredentialType:%d Organization:%d Inputs:%s}",
+		c.ID, c.Name, c.Kind, c.CredentialType, c.Organization, c.Inputs)
+}
+
+// SecretFields returns the ids of the inputs marked as secret.
+func (t *CredentialType) SecretFields() []string {
+	result := make([]string, 0)
+	for _, field := range t.Inputs.Fields {
+		if field.Secret {
+			result = append(result, field.ID)
+		}
+	}
+
+	return result
+}
+
+// CredentialService implements awx credentials, credential types and credential input sources apis.
+type CredentialService struct {
+	Requester *Requester
+}
+
+// ListCredentials shows list of awx credentials.
+func (c *CredentialService) ListCredentials(ctx context.Context, params ListParams) (*ListCredentials, error) {
+	result := ListCredentials{}
+	endpoint := "/api/v2/credentials/"
+
+	_, err := c.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CredentialsIter returns an iterator over all awx credentials, following pagination.
+func (c *CredentialService) CredentialsIter(params ListParams, opts *ListOptions) *Iterator[*Credential] {
+	return newIterator[*Credential](c.Requester, "/api/v2/credentials/", params, opts)
+}
+
+// ListAllCredentials shows list of awx credentials from every page.
+func (c *CredentialService) ListAllCredentials(ctx context.Context, params ListParams, opts *ListOptions) ([]*Credential, error) {
+	return c.CredentialsIter(params, opts).All(ctx)
+}
+
+// GetCredential retrives the credential information from its ID.
+// Secret inputs are returned by awx as `$encrypted$`.
+func (c *CredentialService) GetCredential(ctx context.Context, id int) (*Credential, error) {
+	result := Credential{}
+	endpoint := fmt.Sprintf("/api/v2/credentials/%d/", id)
+
+	_, err := c.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateCredential creates an awx credential.
+//
+//	name TEXT *REQUIRED
+//	credential_type ID *REQUIRED
+//	description TEXT
+//	organization ID
+//	user ID
+//	team ID
+//	inputs JSON
+func (c *CredentialService) CreateCredential(ctx context.Context, data map[string]interface{}) (*Credential, error) {
+	result := Credential{}
+	endpoint := "/api/v2/credentials/"
+
+	validate, status := ValidateParams(data, []string{"name", "credential_type"})
+	if !status {
+		return nil, fmt.Errorf("mandatory input arguments are absent: %s", validate)
+	}
+
+	_, err := c.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateCredential update an awx credential.
+// Note that `inputs` are replaced as a whole, pass `$encrypted$` to keep a secret unchanged.
+func (c *CredentialService) UpdateCredential(ctx context.Context, id int, data map[string]interface{}) (*Credential, error) {
+	result := Credential{}
+	endpoint := fmt.Sprintf("/api/v2/credentials/%d", id)
+
+	_, err := c.Requester.Patch(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteCredential delete an awx credential.
+func (c *CredentialService) DeleteCredential(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/credentials/%d", id)
+
+	_, err := c.Requester.Delete(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CopyCredential copies an awx credential with a new name.
+func (c *CredentialService) CopyCredential(ctx context.Context, id int, name string) (*Credential, error) {
+	result := Credential{}
+	endpoint := fmt.Sprintf("/api/v2/credentials/%d/copy/", id)
+
+	payload := map[string]interface{}{
+		"name": name,
+	}
+
+	_, err := c.Requester.Post(ctx, endpoint, payload, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// TestCredential checks an external credential against its secret backend with the metadata.
+func (c *CredentialService) TestCredential(ctx context.Context, id int, metadata map[string]interface{}) error {
+	endpoint := fmt.Sprintf("/api/v2/credentials/%d/test/", id)
+
+	payload := map[string]interface{}{
+		"metadata": metadata,
+	}
+
+	_, err := c.Requester.Post(ctx, endpoint, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AttachToJobTemplate adds the credential to a job template.
+func (c *CredentialService) AttachToJobTemplate(ctx context.Context, id int, jobTemplateID int) error {
+	endpoint := fmt.Sprintf("/api/v2/job_templates/%d/credentials/", jobTemplateID)
+
+	payload := map[string]interface{}{
+		"id": id,
+	}
+
+	_, err := c.Requester.Post(ctx, endpoint, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DetachFromJobTemplate removes the credential from a job template.
+func (c *CredentialService) DetachFromJobTemplate(ctx context.Context, id int, jobTemplateID int) error {
+	endpoint := fmt.Sprintf("/api/v2/job_templates/%d/credentials/", jobTemplateID)
+
+	payload := map[string]interface{}{
+		"id":           id,
+		"disassociate": true,
+	}
+
+	_, err := c.Requester.Post(ctx, endpoint, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListJobTemplateCredentials shows list of credentials attached to a job template.
+func (c *CredentialService) ListJobTemplateCredentials(ctx context.Context, jobTemplateID int, params ListParams) (*ListCredentials, error) {
+	result := ListCredentials{}
+	endpoint := fmt.Sprintf("/api/v2/job_templates/%d/credentials/", jobTemplateID)
+
+	_, err := c.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListCredentialTypes shows list of awx credential types.
+func (c *CredentialService) ListCredentialTypes(ctx context.Context, params ListParams) (*ListCredentialTypes, error) {
+	result := ListCredentialTypes{}
+	endpoint := "/api/v2/credential_types/"
+
+	_, err := c.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CredentialTypesIter returns an iterator over all awx credential types, following pagination.
+func (c *CredentialService) CredentialTypesIter(params ListParams, opts *ListOptions) *Iterator[*CredentialType] {
+	return newIterator[*CredentialType](c.Requester, "/api/v2/credential_types/", params, opts)
+}
+
+// GetCredentialType retrives the credential type information from its ID.
+func (c *CredentialService) GetCredentialType(ctx context.Context, id int) (*CredentialType, error) {
+	result := CredentialType{}
+	endpoint := fmt.Sprintf("/api/v2/credential_types/%d/", id)
+
+	_, err := c.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateCredentialType creates a custom awx credential type.
+//
+//	name TEXT *REQUIRED
+//	kind {net,cloud} *REQUIRED
+//	description TEXT
+//	inputs JSON
+//	injectors JSON
+func (c *CredentialService) CreateCredentialType(ctx context.Context, data map[string]interface{}) (*CredentialType, error) {
+	result := CredentialType{}
+	endpoint := "/api/v2/credential_types/"
+
+	validate, status := ValidateParams(data, []string{"name", "kind"})
+	if !status {
+		return nil, fmt.Errorf("mandatory input arguments are absent: %s", validate)
+	}
+
+	_, err := c.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateCredentialType update a custom awx credential type.
+func (c *CredentialService) UpdateCredentialType(ctx context.Context, id int, data map[string]interface{}) (*CredentialType, error) {
+	result := CredentialType{}
+	endpoint := fmt.Sprintf("/api/v2/credential_types/%d", id)
+
+	_, err := c.Requester.Patch(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteCredentialType delete a custom awx credential type.
+func (c *CredentialService) DeleteCredentialType(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/credential_types/%d", id)
+
+	_, err := c.Requester.Delete(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TestCredentialType checks the inputs of an external credential type against its secret backend
+// without creating a credential.
+func (c *CredentialService) TestCredentialType(ctx context.Context, id int, inputs CredentialInputs, metadata map[string]interface{}) error {
+	endpoint := fmt.Sprintf("/api/v2/credential_types/%d/test/", id)
+
+	payload := map[string]interface{}{
+		"inputs":   inputs,
+		"metadata": metadata,
+	}
+
+	_, err := c.Requester.Post(ctx, endpoint, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListCredentialInputSources shows list of awx credential input sources.
+func (c *CredentialService) ListCredentialInputSources(ctx context.Context, params ListParams) (*ListCredentialInputSources, error) {
+	result := ListCredentialInputSources{}
+	endpoint := "/api/v2/credential_input_sources/"
+
+	_, err := c.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListCredentialInputSourcesByCredentialID shows list of input sources of specify target credential.
+func (c *CredentialService) ListCredentialInputSourcesByCredentialID(ctx context.Context, id int, params ListParams) (*ListCredentialInputSources, error) {
+	result := ListCredentialInputSources{}
+	endpoint := fmt.Sprintf("/api/v2/credentials/%d/input_sources/", id)
+
+	_, err := c.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetCredentialInputSource retrives the credential input source information from its ID.
+func (c *CredentialService) GetCredentialInputSource(ctx context.Context, id int) (*CredentialInputSource, error) {
+	result := CredentialInputSource{}
+	endpoint := fmt.Sprintf("/api/v2/credential_input_sources/%d/", id)
+
+	_, err := c.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateCredentialInputSource creates an awx credential input source.
+//
+//	input_field_name TEXT *REQUIRED
+//	target_credential ID *REQUIRED
+//	source_credential ID *REQUIRED
+//	description TEXT
+//	metadata JSON
+func (c *CredentialService) CreateCredentialInputSource(ctx context.Context, data map[string]interface{}) (*CredentialInputSource, error) {
+	result := CredentialInputSource{}
+	endpoint := "/api/v2/credential_input_sources/"
+
+	validate, status := ValidateParams(data, []string{"input_field_name", "target_credential", "source_credential"})
+	if !status {
+		return nil, fmt.Errorf("mandatory input arguments are absent: %s", validate)
+	}
+
+	_, err := c.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateCredentialInputSource update an awx credential input source.
+func (c *CredentialService) UpdateCredentialInputSource(ctx context.Context, id int, data map[string]interface{}) (*CredentialInputSource, error) {
+	result := CredentialInputSource{}
+	endpoint := fmt.Sprintf("/api/v2/credential_input_sources/%d", id)
+
+	_, err := c.Requester.Patch(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteCredentialInputSource delete an awx credential input source.
+func (c *CredentialService) DeleteCredentialInputSource(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/credential_input_sources/%d", id)
+
+	_, err := c.Requester.Delete(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
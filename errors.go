@@ -0,0 +1,149 @@
+package awx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// APIError represents a non-2xx response of the awx api.
+//
+// AWX reports problems either as `{"detail": "..."}` or, for validation
+// failures, as `{"field": ["message", ...]}`. Both shapes are decoded,
+// the raw body is always kept in Body.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Body       []byte
+	Detail     string
+	Fields     map[string][]string
+}
+
+func newAPIError(req *http.Request, response *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: response.StatusCode,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		Body:       body,
+	}
+
+	decoded := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return apiErr
+	}
+
+	for key, raw := range decoded {
+		if key == "detail" || key == "error" {
+			var detail string
+			if err := json.Unmarshal(raw, &detail); err == nil {
+				if apiErr.Detail == "" || key == "detail" {
+					apiErr.Detail = detail
+				}
+				continue
+			}
+		}
+
+		if apiErr.Fields == nil {
+			apiErr.Fields = map[string][]string{}
+		}
+		apiErr.Fields[key] = decodeFieldMessages(raw)
+	}
+
+	return apiErr
+}
+
+// decodeFieldMessages accepts `["msg"]`, `"msg"` or any nested structure,
+// the last one is kept as its json representation.
+func decodeFieldMessages(raw json.RawMessage) []string {
+	var messages []string
+	if err := json.Unmarshal(raw, &messages); err == nil {
+		return messages
+	}
+
+	var message string
+	if err := json.Unmarshal(raw, &message); err == nil {
+		return []string{message}
+	}
+
+	return []string{string(raw)}
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s %s: response code %d, resp: %s", e.Method, e.URL, e.StatusCode, e.message())
+}
+
+func (e *APIError) message() string {
+	if e.Detail != "" && len(e.Fields) == 0 {
+		return e.Detail
+	}
+
+	if len(e.Fields) > 0 {
+		keys := make([]string, 0, len(e.Fields))
+		for key := range e.Fields {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		parts := make([]string, 0, len(keys)+1)
+		if e.Detail != "" {
+			parts = append(parts, e.Detail)
+		}
+		for _, key := range keys {
+			parts = append(parts, fmt.Sprintf("%s: %s", key, strings.Join(e.Fields[key], " ")))
+		}
+
+		return strings.Join(parts, "; ")
+	}
+
+	return string(e.Body)
+}
+
+// FieldErrors returns validation messages of a single field.
+func (e *APIError) FieldErrors(field string) []string {
+	return e.Fields[field]
+}
+
+// AsAPIError returns the *APIError wrapped in err, if any.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+
+	return nil, false
+}
+
+// HasStatus reports whether err is an *APIError with the given status code.
+func HasStatus(err error, code int) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.StatusCode == code
+}
+
+// IsBadRequest reports whether err is an awx 400 response.
+func IsBadRequest(err error) bool {
+	return HasStatus(err, http.StatusBadRequest)
+}
+
+// IsUnauthorized reports whether err is an awx 401 response.
+func IsUnauthorized(err error) bool {
+	return HasStatus(err, http.StatusUnauthorized)
+}
+
+// IsPermissionDenied reports whether err is an awx 403 response.
+func IsPermissionDenied(err error) bool {
+	return HasStatus(err, http.StatusForbidden)
+}
+
+// IsNotFound reports whether err is an awx 404 response.
+func IsNotFound(err error) bool {
+	return HasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is an awx 409 response.
+func IsConflict(err error) bool {
+	return HasStatus(err, http.StatusConflict)
+}
@@ -0,0 +1,255 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Enum of job event types.
+const (
+	EventPlaybookOnStart            = "playbook_on_start"
+	EventPlaybookOnPlayStart        = "playbook_on_play_start"
+	EventPlaybookOnTaskStart        = "playbook_on_task_start"
+	EventPlaybookOnStats            = "playbook_on_stats"
+	EventPlaybookOnNoHostsMatched   = "playbook_on_no_hosts_matched"
+	EventRunnerOnStart              = "runner_on_start"
+	EventRunnerOnOk                 = "runner_on_ok"
+	EventRunnerOnFailed             = "runner_on_failed"
+	EventRunnerOnSkipped            = "runner_on_skipped"
+	EventRunnerOnUnreachable        = "runner_on_unreachable"
+	EventRunnerItemOnOk             = "runner_item_on_ok"
+	EventRunnerItemOnFailed         = "runner_item_on_failed"
+	EventRunnerItemOnSkipped        = "runner_item_on_skipped"
+	EventRunnerRetry                = "runner_retry"
+	EventVerbose                    = "verbose"
+	EventDebug                      = "debug"
+	EventWarning                    = "warning"
+	EventError                      = "error"
+	EventPlaybookOnNotify           = "playbook_on_notify"
+	EventPlaybookOnInclude          = "playbook_on_include"
+	EventRunnerOnAsyncPoll          = "runner_on_async_poll"
+	EventRunnerOnAsyncOk            = "runner_on_async_ok"
+	EventRunnerOnAsyncFailed        = "runner_on_async_failed"
+	EventPlaybookOnNoHostsRemaining = "playbook_on_no_hosts_remaining"
+)
+
+// JobEventFilter selects the events delivered by StreamJobEvents.
+// Empty fields match any event. The filter is sent to awx, so only matching events are read,
+// the `playbook_on_stats` event ending the stream is read on its own once the job finished.
+type JobEventFilter struct {
+	Events   []string
+	HostName string
+	Failed   *bool
+	Changed  *bool
+
+	// Interval is the delay between polls, 1 second by default.
+	Interval time.Duration
+	// PageSize is the `page_size` used to read the events.
+	PageSize int
+}
+
+// Match reports whether the event passes the filter.
+func (f *JobEventFilter) Match(event *JobEvent) bool {
+	if f == nil {
+		return true
+	}
+
+	if len(f.Events) > 0 {
+		found := false
+		for _, name := range f.Events {
+			if event.Event == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if f.HostName != "" && event.HostName != f.HostName {
+		return false
+	}
+	if f.Failed != nil && event.Failed != *f.Failed {
+		return false
+	}
+	if f.Changed != nil && event.Changed != *f.Changed {
+		return false
+	}
+
+	return true
+}
+
+// params renders the filter as list params of the events endpoint.
+func (f *JobEventFilter) params() map[string]string {
+	params := map[string]string{}
+	if f == nil {
+		return params
+	}
+
+	if len(f.Events) > 0 {
+		params["event__in"] = strings.Join(f.Events, ",")
+	}
+	if f.HostName != "" {
+		params["host_name"] = f.HostName
+	}
+	if f.Failed != nil {
+		params["failed"] = strconv.FormatBool(*f.Failed)
+	}
+	if f.Changed != nil {
+		params["changed"] = strconv.FormatBool(*f.Changed)
+	}
+
+	return params
+}
+
+// StreamJobEvents follows the events of a job in real time.
+//
+// The events channel is closed after the `playbook_on_stats` event, even when the filter
+// does not deliver it, once the job finished without it, or on error. The error channel receives
+// at most one error and is closed together with the events channel.
+//
+//	events, errs := client.JobService.StreamJobEvents(ctx, id, &awx.JobEventFilter{
+//		Events: []string{awx.EventRunnerOnFailed},
+//	})
+//	for event := range events {
+//		...
+//	}
+//	if err := <-errs; err != nil {
+//		...
+//	}
+func (j *JobService) StreamJobEvents(ctx context.Context, id int, filter *JobEventFilter) (<-chan JobEvent, <-chan error) {
+	events := make(chan JobEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(events)
+
+		if err := j.streamJobEvents(ctx, id, filter, events); err != nil {
+			errs <- err
+		}
+	}()
+
+	return events, errs
+}
+
+func (j *JobService) streamJobEvents(ctx context.Context, id int, filter *JobEventFilter, events chan<- JobEvent) error {
+	endpoint := fmt.Sprintf("/api/v2/jobs/%d/job_events/", id)
+
+	return streamEvents(ctx, j.Requester, endpoint, filter, events, func(ctx context.Context) (string, bool, error) {
+		job, err := j.GetJob(ctx, id, map[string]string{})
+		if err != nil {
+			return "", false, err
+		}
+
+		return job.Status, job.EventProcessingFinished, nil
+	})
+}
+
+// streamEvents polls any unified job events endpoint until the job finishes.
+// state reports the job status and whether all its events are processed.
+func streamEvents(ctx context.Context, requester *Requester, endpoint string, filter *JobEventFilter, events chan<- JobEvent,
+	state func(ctx context.Context) (string, bool, error)) error {
+	interval := time.Second
+	opts := &ListOptions{}
+	if filter != nil {
+		if filter.Interval > 0 {
+			interval = filter.Interval
+		}
+		opts.PageSize = filter.PageSize
+	}
+
+	last := 0
+	idle := 0
+	for {
+		// The job is fetched before the events, so nothing is missed when it is already finished.
+		status, eventsFinished, err := state(ctx)
+		if err != nil {
+			return err
+		}
+
+		params := filter.params()
+		// A filtered read skips the stats event, it is looked up separately after the events.
+		findStats := len(params) > 0 && IsFinishedStatus(status)
+		params["counter__gt"] = strconv.Itoa(last)
+		params["order_by"] = "counter"
+
+		received := 0
+		it := newIterator[JobEvent](requester, endpoint, params, opts)
+
+		for it.Next(ctx) {
+			event := it.Value()
+			received++
+			if event.Counter > last {
+				last = event.Counter
+			}
+
+			if filter.Match(&event) {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+
+			if event.Event == EventPlaybookOnStats {
+				return nil
+			}
+		}
+
+		if err := it.Err(); err != nil {
+			return err
+		}
+
+		if findStats {
+			stats, err := statsEvent(ctx, requester, endpoint)
+			if err != nil {
+				return err
+			}
+
+			if stats != nil {
+				if filter.Match(stats) {
+					select {
+					case events <- *stats:
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				}
+				return nil
+			}
+		}
+
+		if IsFinishedStatus(status) && received == 0 {
+			idle++
+			if eventsFinished || idle >= idlePollsAfterFinish {
+				return nil
+			}
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
+// statsEvent reads the `playbook_on_stats` event of the job, nil when it is not there yet.
+func statsEvent(ctx context.Context, requester *Requester, endpoint string) (*JobEvent, error) {
+	result := Page[JobEvent]{}
+	_, err := requester.Get(ctx, endpoint, &result, map[string]string{
+		"event":     EventPlaybookOnStats,
+		"page_size": "1",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Results) == 0 {
+		return nil, nil
+	}
+
+	return &result.Results[0], nil
+}
@@ -26,7 +26,7 @@ type GroupService struct {
 //	description: Optional description of this group. (string)
 //	inventory: (id)
 //	variables: Group variables in JSON or YAML format. (json)
-func (g *GroupService) ListGroups(ctx context.Context, params map[string]string) (*ListGroups, error) {
+func (g *GroupService) ListGroups(ctx context.Context, params ListParams) (*ListGroups, error) {
 	result := ListGroups{}
 	endpoint := "/api/v2/groups/"
 
@@ -42,12 +42,28 @@ func (g *GroupService) ListGroups(ctx context.Context, params map[string]string)
 	return &result, nil
 }
 
+// GroupsIter returns an iterator over all awx Groups, following pagination.
+func (g *GroupService) GroupsIter(params ListParams, opts *ListOptions) *Iterator[*Group] {
+	return newIterator[*Group](g.Requester, "/api/v2/groups/", params, opts)
+}
+
+// ListAllGroups shows list of awx Groups from every page.
+func (g *GroupService) ListAllGroups(ctx context.Context, params ListParams, opts *ListOptions) ([]*Group, error) {
+	return g.GroupsIter(params, opts).All(ctx)
+}
+
 // ListGroupsByInventoryId shows list of groups that created in specify inventory.
-func (g *GroupService) ListGroupsByInventoryId(ctx context.Context, inventoryId int) (*ListGroups, error) {
+// The optional params filter the list.
+func (g *GroupService) ListGroupsByInventoryId(ctx context.Context, inventoryId int, params ...ListParams) (*ListGroups, error) {
 	result := ListGroups{}
 	endpoint := fmt.Sprintf("/api/v2/inventories/%d/groups/", inventoryId)
 
-	_, err := g.Requester.Get(ctx, endpoint, &result, nil)
+	var query ListParams
+	if len(params) > 0 {
+		query = params[0]
+	}
+
+	_, err := g.Requester.Get(ctx, endpoint, &result, query)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +75,17 @@ func (g *GroupService) ListGroupsByInventoryId(ctx context.Context, inventoryId
 	return &result, nil
 }
 
+// GroupsByInventoryIdIter returns an iterator over all groups of specify inventory.
+func (g *GroupService) GroupsByInventoryIdIter(inventoryId int, params ListParams, opts *ListOptions) *Iterator[*Group] {
+	endpoint := fmt.Sprintf("/api/v2/inventories/%d/groups/", inventoryId)
+	return newIterator[*Group](g.Requester, endpoint, params, opts)
+}
+
+// ListAllGroupsByInventoryId shows list of groups from every page of specify inventory.
+func (g *GroupService) ListAllGroupsByInventoryId(ctx context.Context, inventoryId int, params ListParams, opts *ListOptions) ([]*Group, error) {
+	return g.GroupsByInventoryIdIter(inventoryId, params, opts).All(ctx)
+}
+
 // CreateGroup creates an awx Group.
 func (g *GroupService) CreateGroup(ctx context.Context, data map[string]interface{}) (*Group, error) {
 	result := Group{}
@@ -11,7 +11,7 @@ type HostService struct {
 }
 
 // ListHosts shows list of awx Hosts.
-func (h *HostService) ListHosts(ctx context.Context, params map[string]string) (*ListHosts, error) {
+func (h *HostService) ListHosts(ctx context.Context, params ListParams) (*ListHosts, error) {
 	result := ListHosts{}
 	endpoint := "/api/v2/hosts/"
 
@@ -23,6 +23,16 @@ func (h *HostService) ListHosts(ctx context.Context, params map[string]string) (
 	return &result, nil
 }
 
+// HostsIter returns an iterator over all awx Hosts, following pagination.
+func (h *HostService) HostsIter(params ListParams, opts *ListOptions) *Iterator[*Host] {
+	return newIterator[*Host](h.Requester, "/api/v2/hosts/", params, opts)
+}
+
+// ListAllHosts shows list of awx Hosts from every page.
+func (h *HostService) ListAllHosts(ctx context.Context, params ListParams, opts *ListOptions) ([]*Host, error) {
+	return h.HostsIter(params, opts).All(ctx)
+}
+
 // CreateHost creates an awx Host.
 //
 //	name TEXT
@@ -82,7 +92,7 @@ func (h *HostService) AssociateGroup(ctx context.Context, id int, data map[strin
 }
 
 // DisAssociateGroup update an awx Host
-func (h *HostService) DisAssociateGroup(ctx context.Context, id int, data map[string]interface{}, params map[string]string) (*Host, error) {
+func (h *HostService) DisAssociateGroup(ctx context.Context, id int, data map[string]interface{}, params ListParams) (*Host, error) {
 	result := Host{}
 	endpoint := fmt.Sprintf("/api/v2/hosts/%d/groups/", id)
 	data["disassociate"] = true
@@ -113,14 +123,31 @@ func (h *HostService) DeleteHost(ctx context.Context, id int) error {
 }
 
 // ListInventoryHosts shows list of awx Hosts from specified inventory.
-func (h *HostService) ListInventoryHosts(ctx context.Context, inventoryId int) (*ListHosts, error) {
+// The optional params filter the list.
+func (h *HostService) ListInventoryHosts(ctx context.Context, inventoryId int, params ...ListParams) (*ListHosts, error) {
 	result := ListHosts{}
 	endpoint := fmt.Sprintf("/api/v2/inventories/%d/hosts/", inventoryId)
 
-	_, err := h.Requester.Get(ctx, endpoint, &result, nil)
+	var query ListParams
+	if len(params) > 0 {
+		query = params[0]
+	}
+
+	_, err := h.Requester.Get(ctx, endpoint, &result, query)
 	if err != nil {
 		return nil, err
 	}
 
 	return &result, nil
 }
+
+// InventoryHostsIter returns an iterator over all awx Hosts from specified inventory.
+func (h *HostService) InventoryHostsIter(inventoryId int, params ListParams, opts *ListOptions) *Iterator[*Host] {
+	endpoint := fmt.Sprintf("/api/v2/inventories/%d/hosts/", inventoryId)
+	return newIterator[*Host](h.Requester, endpoint, params, opts)
+}
+
+// ListAllInventoryHosts shows list of awx Hosts from every page of specified inventory.
+func (h *HostService) ListAllInventoryHosts(ctx context.Context, inventoryId int, params ListParams, opts *ListOptions) ([]*Host, error) {
+	return h.InventoryHostsIter(inventoryId, params, opts).All(ctx)
+}
@@ -11,7 +11,7 @@ type InventoriesService struct {
 }
 
 // ListInventories shows list of awx inventories.
-func (i *InventoriesService) ListInventories(ctx context.Context, params map[string]string) (*ListInventories, error) {
+func (i *InventoriesService) ListInventories(ctx context.Context, params ListParams) (*ListInventories, error) {
 	result := ListInventories{}
 	endpoint := "/api/v2/inventories/"
 
@@ -23,6 +23,16 @@ func (i *InventoriesService) ListInventories(ctx context.Context, params map[str
 	return &result, nil
 }
 
+// InventoriesIter returns an iterator over all awx inventories, following pagination.
+func (i *InventoriesService) InventoriesIter(params ListParams, opts *ListOptions) *Iterator[*Inventory] {
+	return newIterator[*Inventory](i.Requester, "/api/v2/inventories/", params, opts)
+}
+
+// ListAllInventories shows list of awx inventories from every page.
+func (i *InventoriesService) ListAllInventories(ctx context.Context, params ListParams, opts *ListOptions) ([]*Inventory, error) {
+	return i.InventoriesIter(params, opts).All(ctx)
+}
+
 // CreateInventory creates an awx inventory.
 func (i *InventoriesService) CreateInventory(ctx context.Context, data map[string]interface{}) (*Inventory, error) {
 	result := Inventory{}
@@ -0,0 +1,245 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Enum of inventory source types.
+const (
+	InventorySourceSCM         = "scm"
+	InventorySourceEC2         = "ec2"
+	InventorySourceGCE         = "gce"
+	InventorySourceAzure       = "azure_rm"
+	InventorySourceVMware      = "vmware"
+	InventorySourceSatellite6  = "satellite6"
+	InventorySourceOpenStack   = "openstack"
+	InventorySourceRHV         = "rhv"
+	InventorySourceController  = "controller"
+	InventorySourceInsights    = "insights"
+	InventorySourceTerraform   = "terraform"
+	InventorySourceConstructed = "constructed"
+)
+
+// InventorySourceService implements awx inventory source apis.
+type InventorySourceService struct {
+	Requester *Requester
+}
+
+// ListInventorySources shows a list of inventory sources.
+func (is *InventorySourceService) ListInventorySources(ctx context.Context, params ListParams) (*ListInventorySources, error) {
+	result := ListInventorySources{}
+	endpoint := "/api/v2/inventory_sources/"
+
+	_, err := is.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// InventorySourcesIter returns an iterator over all inventory sources, following pagination.
+func (is *InventorySourceService) InventorySourcesIter(params ListParams, opts *ListOptions) *Iterator[*InventorySource] {
+	return newIterator[*InventorySource](is.Requester, "/api/v2/inventory_sources/", params, opts)
+}
+
+// ListAllInventorySources shows a list of inventory sources from every page.
+func (is *InventorySourceService) ListAllInventorySources(ctx context.Context, params ListParams, opts *ListOptions) ([]*InventorySource, error) {
+	return is.InventorySourcesIter(params, opts).All(ctx)
+}
+
+// ListInventorySourcesByInventoryID shows the sources of an inventory.
+func (is *InventorySourceService) ListInventorySourcesByInventoryID(ctx context.Context, id int, params ListParams) (*ListInventorySources, error) {
+	result := ListInventorySources{}
+	endpoint := fmt.Sprintf("/api/v2/inventories/%d/inventory_sources/", id)
+
+	_, err := is.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetInventorySource retrives the inventory source information from its ID.
+func (is *InventorySourceService) GetInventorySource(ctx context.Context, id int) (*InventorySource, error) {
+	result := InventorySource{}
+	endpoint := fmt.Sprintf("/api/v2/inventory_sources/%d/", id)
+
+	_, err := is.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateInventorySource creates an inventory source.
+// source_vars may be given as a map, it is sent as json.
+//
+//	name TEXT *REQUIRED
+//	inventory ID *REQUIRED
+//	source {scm,ec2,gce,azure_rm,vmware,satellite6,openstack,rhv,controller,insights,terraform,constructed} *REQUIRED
+//	description TEXT
+//	source_path TEXT
+//	source_project ID
+//	source_vars JSON/YAML
+//	scm_branch TEXT
+//	credential ID
+//	enabled_var TEXT
+//	enabled_value TEXT
+//	host_filter TEXT
+//	overwrite BOOLEAN
+//	overwrite_vars BOOLEAN
+//	timeout INTEGER
+//	verbosity {0,1,2}
+//	limit TEXT
+//	execution_environment ID
+//	update_on_launch BOOLEAN
+//	update_cache_timeout INTEGER
+func (is *InventorySourceService) CreateInventorySource(ctx context.Context, data map[string]interface{}) (*InventorySource, error) {
+	result := InventorySource{}
+	endpoint := "/api/v2/inventory_sources/"
+
+	validate, status := ValidateParams(data, []string{"name", "inventory", "source"})
+	if !status {
+		return nil, fmt.Errorf("mandatory input arguments are absent: %s", validate)
+	}
+
+	data, err := encodeSourceVars(data)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = is.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateInventorySource update an inventory source.
+// source_vars may be given as a map, it is sent as json.
+func (is *InventorySourceService) UpdateInventorySource(ctx context.Context, id int, data map[string]interface{}) (*InventorySource, error) {
+	result := InventorySource{}
+	endpoint := fmt.Sprintf("/api/v2/inventory_sources/%d", id)
+
+	data, err := encodeSourceVars(data)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = is.Requester.Patch(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteInventorySource delete an inventory source.
+func (is *InventorySourceService) DeleteInventorySource(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/inventory_sources/%d", id)
+
+	_, err := is.Requester.Delete(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncInventorySource starts an update of the inventory source.
+// The ID of the started update is returned in InventoryUpdate field.
+func (is *InventorySourceService) SyncInventorySource(ctx context.Context, id int) (*InventorySourceUpdate, error) {
+	result := InventorySourceUpdate{}
+	endpoint := fmt.Sprintf("/api/v2/inventory_sources/%d/update/", id)
+
+	_, err := is.Requester.Post(ctx, endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.InventoryUpdate == 0 {
+		result.InventoryUpdate = result.ID
+	}
+
+	return &result, nil
+}
+
+// ListInventorySourceUpdates shows the updates of an inventory source.
+func (is *InventorySourceService) ListInventorySourceUpdates(ctx context.Context, id int, params ListParams) (*ListInventoryUpdates, error) {
+	result := ListInventoryUpdates{}
+	endpoint := fmt.Sprintf("/api/v2/inventory_sources/%d/inventory_updates/", id)
+
+	_, err := is.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ErrSourceVarsNotJSON is returned by SourceVarsMap for source vars which are not json,
+// usually yaml written by the awx ui. Decode InventorySource.SourceVars with a yaml library then.
+var ErrSourceVarsNotJSON = errors.New("source vars are not json, likely yaml")
+
+// SourceVarsMap decodes the source vars of the inventory source.
+// Empty vars, including a bare yaml `---` document, decode to an empty map.
+// Only json is decoded, optionally after a `---` line, other vars return an error wrapping ErrSourceVarsNotJSON.
+func (s *InventorySource) SourceVarsMap() (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	if emptySourceVars(s.SourceVars) {
+		return result, nil
+	}
+
+	// Yaml is a superset of json, a leading document marker may precede a json object.
+	vars := strings.TrimSpace(s.SourceVars)
+	if strings.HasPrefix(vars, "---\n") {
+		vars = vars[len("---\n"):]
+	}
+
+	if err := json.Unmarshal([]byte(vars), &result); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSourceVarsNotJSON, err)
+	}
+
+	return result, nil
+}
+
+// emptySourceVars reports whether the vars hold nothing but yaml document markers and comments.
+func emptySourceVars(vars string) bool {
+	for _, line := range strings.Split(vars, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && line != "---" && line != "..." && line != "{}" && !strings.HasPrefix(line, "#") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// encodeSourceVars renders map source vars as json, data is copied to keep the caller map intact.
+func encodeSourceVars(data map[string]interface{}) (map[string]interface{}, error) {
+	vars, ok := data["source_vars"].(map[string]interface{})
+	if !ok {
+		return data, nil
+	}
+
+	rendered, err := json.Marshal(vars)
+	if err != nil {
+		return nil, fmt.Errorf("source vars: %w", err)
+	}
+
+	result := make(map[string]interface{}, len(data))
+	for key, value := range data {
+		result[key] = value
+	}
+	result["source_vars"] = string(rendered)
+
+	return result, nil
+}
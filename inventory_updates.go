@@ -0,0 +1,109 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// InventoryUpdateService implements awx inventory update apis.
+type InventoryUpdateService struct {
+	Requester *Requester
+}
+
+// ListInventoryUpdates shows a list of inventory updates.
+func (iu *InventoryUpdateService) ListInventoryUpdates(ctx context.Context, params ListParams) (*ListInventoryUpdates, error) {
+	result := ListInventoryUpdates{}
+	endpoint := "/api/v2/inventory_updates/"
+
+	_, err := iu.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// InventoryUpdatesIter returns an iterator over all inventory updates, following pagination.
+func (iu *InventoryUpdateService) InventoryUpdatesIter(params ListParams, opts *ListOptions) *Iterator[*InventoryUpdate] {
+	return newIterator[*InventoryUpdate](iu.Requester, "/api/v2/inventory_updates/", params, opts)
+}
+
+// GetInventoryUpdate shows the details of an inventory update.
+func (iu *InventoryUpdateService) GetInventoryUpdate(ctx context.Context, id int) (*InventoryUpdate, error) {
+	result := InventoryUpdate{}
+	endpoint := fmt.Sprintf("/api/v2/inventory_updates/%d/", id)
+
+	_, err := iu.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CancelInventoryUpdate cancels an inventory update.
+func (iu *InventoryUpdateService) CancelInventoryUpdate(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/inventory_updates/%d/cancel/", id)
+
+	_, err := iu.Requester.Post(ctx, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Wait polls the inventory update until it reaches one of the finished statuses.
+// A *JobFailedError is returned along with the update when it is failed, error or canceled.
+// The wait is bounded by ctx only, use context.WithTimeout to limit it.
+func (iu *InventoryUpdateService) Wait(ctx context.Context, id int, opts *WaitOptions) (*InventoryUpdate, error) {
+	return waitFor(ctx, id, opts, func(ctx context.Context) (*InventoryUpdate, jobState, error) {
+		update, err := iu.GetInventoryUpdate(ctx, id)
+		if err != nil {
+			return nil, jobState{}, err
+		}
+
+		return update, jobState{
+			Type:            "inventory_update",
+			Status:          update.Status,
+			JobExplanation:  update.JobExplanation,
+			ResultTraceback: update.ResultTraceback,
+		}, nil
+	})
+}
+
+// GetInventoryUpdateStdout shows the output of an inventory update in txt, ansi, json or html format.
+func (iu *InventoryUpdateService) GetInventoryUpdateStdout(ctx context.Context, id int, format string, opts *StdoutOptions) ([]byte, error) {
+	endpoint := fmt.Sprintf("/api/v2/inventory_updates/%d/stdout/", id)
+	return getStdout(ctx, iu.Requester, endpoint, format, opts)
+}
+
+// GetInventoryUpdateStdoutJSON shows the output of an inventory update along with the range of returned lines.
+func (iu *InventoryUpdateService) GetInventoryUpdateStdoutJSON(ctx context.Context, id int, opts *StdoutOptions) (*Stdout, error) {
+	endpoint := fmt.Sprintf("/api/v2/inventory_updates/%d/stdout/", id)
+	return getStdoutJSON(ctx, iu.Requester, endpoint, opts)
+}
+
+// FollowStdout writes the output of an inventory update into w while it is running
+// and returns the update once it finished and the whole output is written.
+// The update status is not treated as an error, check it on the returned update.
+func (iu *InventoryUpdateService) FollowStdout(ctx context.Context, id int, w io.Writer, opts *FollowOptions) (*InventoryUpdate, error) {
+	var update *InventoryUpdate
+	endpoint := fmt.Sprintf("/api/v2/inventory_updates/%d/stdout/", id)
+
+	err := followStdout(ctx, iu.Requester, endpoint, w, opts, func(ctx context.Context) (string, bool, error) {
+		var err error
+		update, err = iu.GetInventoryUpdate(ctx, id)
+		if err != nil {
+			return "", false, err
+		}
+
+		return update.Status, update.EventProcessingFinished, nil
+	})
+	if err != nil {
+		return update, err
+	}
+
+	return update, nil
+}
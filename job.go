@@ -3,6 +3,7 @@ package awx
 import (
 	"context"
 	"fmt"
+	"io"
 )
 
 // Enum of job statuses.
@@ -23,7 +24,7 @@ type JobService struct {
 }
 
 // GetJob shows the details of a job.
-func (j *JobService) GetJob(ctx context.Context, id int, params map[string]string) (*Job, error) {
+func (j *JobService) GetJob(ctx context.Context, id int, params ListParams) (*Job, error) {
 	result := Job{}
 	endpoint := fmt.Sprintf("/api/v2/jobs/%d/", id)
 
@@ -62,7 +63,7 @@ func (j *JobService) RelaunchJob(ctx context.Context, id int, data map[string]in
 }
 
 // GetHostSummaries get a job hosts summaries.
-func (j *JobService) GetHostSummaries(ctx context.Context, id int, params map[string]string) (*HostSummaries, error) {
+func (j *JobService) GetHostSummaries(ctx context.Context, id int, params ListParams) (*HostSummaries, error) {
 	result := HostSummaries{}
 	endpoint := fmt.Sprintf("/api/v2/jobs/%d/job_host_summaries/", id)
 
@@ -75,7 +76,7 @@ func (j *JobService) GetHostSummaries(ctx context.Context, id int, params map[st
 }
 
 // GetJobEvents get a list of job events.
-func (j *JobService) GetJobEvents(ctx context.Context, id int, params map[string]string) (*JobEvents, error) {
+func (j *JobService) GetJobEvents(ctx context.Context, id int, params ListParams) (*JobEvents, error) {
 	result := JobEvents{}
 	endpoint := fmt.Sprintf("/api/v2/jobs/%d/job_events/", id)
 
@@ -86,3 +87,57 @@ func (j *JobService) GetJobEvents(ctx context.Context, id int, params map[string
 
 	return &result, nil
 }
+
+// Wait polls the job until it reaches one of the finished statuses.
+// A *JobFailedError is returned along with the job when it is failed, error or canceled.
+// The wait is bounded by ctx only, use context.WithTimeout to limit it.
+func (j *JobService) Wait(ctx context.Context, id int, opts *WaitOptions) (*Job, error) {
+	return waitFor(ctx, id, opts, func(ctx context.Context) (*Job, jobState, error) {
+		job, err := j.GetJob(ctx, id, map[string]string{})
+		if err != nil {
+			return nil, jobState{}, err
+		}
+
+		return job, jobState{
+			Type:            "job",
+			Status:          job.Status,
+			JobExplanation:  job.JobExplanation,
+			ResultTraceback: job.ResultTraceback,
+		}, nil
+	})
+}
+
+// GetJobStdout shows the output of a job in txt, ansi, json or html format.
+func (j *JobService) GetJobStdout(ctx context.Context, id int, format string, opts *StdoutOptions) ([]byte, error) {
+	endpoint := fmt.Sprintf("/api/v2/jobs/%d/stdout/", id)
+	return getStdout(ctx, j.Requester, endpoint, format, opts)
+}
+
+// GetJobStdoutJSON shows the output of a job along with the range of returned lines.
+func (j *JobService) GetJobStdoutJSON(ctx context.Context, id int, opts *StdoutOptions) (*Stdout, error) {
+	endpoint := fmt.Sprintf("/api/v2/jobs/%d/stdout/", id)
+	return getStdoutJSON(ctx, j.Requester, endpoint, opts)
+}
+
+// FollowStdout writes the output of a job into w while it is running
+// and returns the job once it finished and the whole output is written.
+// The job status is not treated as an error, check it on the returned job.
+func (j *JobService) FollowStdout(ctx context.Context, id int, w io.Writer, opts *FollowOptions) (*Job, error) {
+	var job *Job
+	endpoint := fmt.Sprintf("/api/v2/jobs/%d/stdout/", id)
+
+	err := followStdout(ctx, j.Requester, endpoint, w, opts, func(ctx context.Context) (string, bool, error) {
+		var err error
+		job, err = j.GetJob(ctx, id, map[string]string{})
+		if err != nil {
+			return "", false, err
+		}
+
+		return job.Status, job.EventProcessingFinished, nil
+	})
+	if err != nil {
+		return job, err
+	}
+
+	return job, nil
+}
@@ -12,7 +12,7 @@ type JobTemplateService struct {
 }
 
 // ListJobTemplates shows a list of job templates.
-func (jt *JobTemplateService) ListJobTemplates(ctx context.Context, params map[string]string) (*ListJobTemplates, error) {
+func (jt *JobTemplateService) ListJobTemplates(ctx context.Context, params ListParams) (*ListJobTemplates, error) {
 	result := ListJobTemplates{}
 	endpoint := "/api/v2/job_templates/"
 
@@ -24,6 +24,16 @@ func (jt *JobTemplateService) ListJobTemplates(ctx context.Context, params map[s
 	return &result, nil
 }
 
+// JobTemplatesIter returns an iterator over all job templates, following pagination.
+func (jt *JobTemplateService) JobTemplatesIter(params ListParams, opts *ListOptions) *Iterator[*JobTemplate] {
+	return newIterator[*JobTemplate](jt.Requester, "/api/v2/job_templates/", params, opts)
+}
+
+// ListAllJobTemplates shows a list of job templates from every page.
+func (jt *JobTemplateService) ListAllJobTemplates(ctx context.Context, params ListParams, opts *ListOptions) ([]*JobTemplate, error) {
+	return jt.JobTemplatesIter(params, opts).All(ctx)
+}
+
 // Launch lauchs a job with the job template
 //
 //	monitor
@@ -48,6 +58,8 @@ func (jt *JobTemplateService) ListJobTemplates(ctx context.Context, params map[s
 // Group = Groupname
 // Multiple Groups = Group1:Group2:Group3
 // Exclude Group in Group = Group1:!Group4
+//
+// See LaunchWithRequest for the typed and validated alternative.
 func (jt *JobTemplateService) Launch(ctx context.Context, id int, data map[string]interface{}) (*JobLaunch, error) {
 	result := JobLaunch{}
 	endpoint := fmt.Sprintf("/api/v2/job_templates/%d/launch/", id)
@@ -65,6 +77,88 @@ func (jt *JobTemplateService) Launch(ctx context.Context, id int, data map[strin
 	return &result, nil
 }
 
+// GetLaunchInfo shows which fields the job template prompts for on launch
+// and what is needed to start it.
+func (jt *JobTemplateService) GetLaunchInfo(ctx context.Context, id int) (*LaunchInfo, error) {
+	result := LaunchInfo{}
+	endpoint := fmt.Sprintf("/api/v2/job_templates/%d/launch/", id)
+
+	_, err := jt.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// LaunchWithRequest validates the request against the launch info of the job template
+// and launches a job. A *LaunchValidationError is returned before anything
+// is started when the template would ignore or reject some of the fields
+// or the extra vars do not answer its survey.
+func (jt *JobTemplateService) LaunchWithRequest(ctx context.Context, id int, request *LaunchRequest) (*JobLaunch, error) {
+	info, err := jt.GetLaunchInfo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec *SurveySpec
+	if info.SurveyEnabled {
+		spec, err = jt.GetSurveySpec(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := request.ValidateWithSurvey(info, spec); err != nil {
+		return nil, err
+	}
+
+	data, err := request.Payload()
+	if err != nil {
+		return nil, err
+	}
+
+	return jt.Launch(ctx, id, data)
+}
+
+// GetSurveySpec shows the survey spec of a job template.
+func (jt *JobTemplateService) GetSurveySpec(ctx context.Context, id int) (*SurveySpec, error) {
+	result := SurveySpec{}
+	endpoint := fmt.Sprintf("/api/v2/job_templates/%d/survey_spec/", id)
+
+	_, err := jt.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SetSurveySpec replaces the survey spec of a job template.
+// The survey is used on launch only while survey_enabled is set on the template.
+func (jt *JobTemplateService) SetSurveySpec(ctx context.Context, id int, spec *SurveySpec) error {
+	endpoint := fmt.Sprintf("/api/v2/job_templates/%d/survey_spec/", id)
+
+	_, err := jt.Requester.Post(ctx, endpoint, spec, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteSurveySpec deletes the survey spec of a job template.
+func (jt *JobTemplateService) DeleteSurveySpec(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/job_templates/%d/survey_spec/", id)
+
+	_, err := jt.Requester.Delete(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // CreateJobTemplate creates a job template
 //
 //	name TEXT *REQUIRED
@@ -0,0 +1,316 @@
+package awx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Enum of job types accepted on launch.
+const (
+	JobTypeRun   = "run"
+	JobTypeCheck = "check"
+)
+
+// LaunchRequest represents the typed payload of a template launch.
+// Zero values are not sent, so the template defaults are used for them.
+type LaunchRequest struct {
+	// ExtraVars are sent as a json object.
+	ExtraVars map[string]interface{}
+	// ExtraVarsYAML is sent as is, it may hold either yaml or json.
+	// It can not be combined with ExtraVars.
+	ExtraVarsYAML string
+
+	Inventory            int
+	Limit                string
+	JobTags              string
+	SkipTags             string
+	JobType              string
+	Verbosity            *int
+	DiffMode             *bool
+	Credentials          []int
+	CredentialPasswords  map[string]string
+	ScmBranch            string
+	ExecutionEnvironment int
+	Forks                *int
+	Timeout              *int
+	JobSliceCount        *int
+	InstanceGroups       []int
+	Labels               []int
+}
+
+// LaunchInfo represents the awx api response of GET on the launch endpoint
+// of job and workflow job templates. It tells which fields are prompted
+// on launch and what is needed to start.
+type LaunchInfo struct {
+	CanStartWithoutUserInput        bool                   `json:"can_start_without_user_input"`
+	PasswordsNeededToStart          []string               `json:"passwords_needed_to_start"`
+	AskScmBranchOnLaunch            bool                   `json:"ask_scm_branch_on_launch"`
+	AskVariablesOnLaunch            bool                   `json:"ask_variables_on_launch"`
+	AskTagsOnLaunch                 bool                   `json:"ask_tags_on_launch"`
+	AskDiffModeOnLaunch             bool                   `json:"ask_diff_mode_on_launch"`
+	AskSkipTagsOnLaunch             bool                   `json:"ask_skip_tags_on_launch"`
+	AskJobTypeOnLaunch              bool                   `json:"ask_job_type_on_launch"`
+	AskLimitOnLaunch                bool                   `json:"ask_limit_on_launch"`
+	AskVerbosityOnLaunch            bool                   `json:"ask_verbosity_on_launch"`
+	AskInventoryOnLaunch            bool                   `json:"ask_inventory_on_launch"`
+	AskCredentialOnLaunch           bool                   `json:"ask_credential_on_launch"`
+	AskExecutionEnvironmentOnLaunch bool                   `json:"ask_execution_environment_on_launch"`
+	AskLabelsOnLaunch               bool                   `json:"ask_labels_on_launch"`
+	AskForksOnLaunch                bool                   `json:"ask_forks_on_launch"`
+	AskJobSliceCountOnLaunch        bool                   `json:"ask_job_slice_count_on_launch"`
+	AskTimeoutOnLaunch              bool                   `json:"ask_timeout_on_launch"`
+	AskInstanceGroupsOnLaunch       bool                   `json:"ask_instance_groups_on_launch"`
+	SurveyEnabled                   bool                   `json:"survey_enabled"`
+	VariablesNeededToStart          []string               `json:"variables_needed_to_start"`
+	CredentialNeededToStart         bool                   `json:"credential_needed_to_start"`
+	InventoryNeededToStart          bool                   `json:"inventory_needed_to_start"`
+	NodeTemplatesMissing            []int                  `json:"node_templates_missing"`
+	NodePromptsRejected             []int                  `json:"node_prompts_rejected"`
+	Defaults                        map[string]interface{} `json:"defaults"`
+}
+
+// LaunchValidationError lists the problems found by LaunchRequest.Validate.
+type LaunchValidationError struct {
+	// Ignored are the fields the template does not prompt for,
+	// AWX would silently drop them and report in JobLaunch.IgnoredFields.
+	Ignored []string
+	// Missing are the values required to start the job.
+	Missing []string
+	// Invalid maps fields to problems with their values.
+	Invalid map[string]string
+}
+
+func (e *LaunchValidationError) Error() string {
+	parts := make([]string, 0, 3)
+	if len(e.Ignored) > 0 {
+		parts = append(parts, fmt.Sprintf("not prompted on launch: %s", strings.Join(e.Ignored, ", ")))
+	}
+	if len(e.Missing) > 0 {
+		parts = append(parts, fmt.Sprintf("needed to start: %s", strings.Join(e.Missing, ", ")))
+	}
+	if len(e.Invalid) > 0 {
+		fields := make([]string, 0, len(e.Invalid))
+		for field := range e.Invalid {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			parts = append(parts, fmt.Sprintf("%s: %s", field, e.Invalid[field]))
+		}
+	}
+
+	return "invalid launch request: " + strings.Join(parts, "; ")
+}
+
+// MarshalJSON renders the request as the awx launch payload.
+func (r *LaunchRequest) MarshalJSON() ([]byte, error) {
+	payload, err := r.Payload()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(payload)
+}
+
+// Payload converts the request into the map accepted by JobTemplateService.Launch.
+func (r *LaunchRequest) Payload() (map[string]interface{}, error) {
+	if r.ExtraVars != nil && r.ExtraVarsYAML != "" {
+		return nil, errors.New("extra vars are set both as map and as yaml")
+	}
+
+	payload := map[string]interface{}{}
+	if r.ExtraVars != nil {
+		payload["extra_vars"] = r.ExtraVars
+	}
+	if r.ExtraVarsYAML != "" {
+		payload["extra_vars"] = r.ExtraVarsYAML
+	}
+	if r.Inventory != 0 {
+		payload["inventory"] = r.Inventory
+	}
+	if r.Limit != "" {
+		payload["limit"] = r.Limit
+	}
+	if r.JobTags != "" {
+		payload["job_tags"] = r.JobTags
+	}
+	if r.SkipTags != "" {
+		payload["skip_tags"] = r.SkipTags
+	}
+	if r.JobType != "" {
+		payload["job_type"] = r.JobType
+	}
+	if r.Verbosity != nil {
+		payload["verbosity"] = *r.Verbosity
+	}
+	if r.DiffMode != nil {
+		payload["diff_mode"] = *r.DiffMode
+	}
+	if r.Credentials != nil {
+		payload["credentials"] = r.Credentials
+	}
+	if r.CredentialPasswords != nil {
+		payload["credential_passwords"] = r.CredentialPasswords
+	}
+	if r.ScmBranch != "" {
+		payload["scm_branch"] = r.ScmBranch
+	}
+	if r.ExecutionEnvironment != 0 {
+		payload["execution_environment"] = r.ExecutionEnvironment
+	}
+	if r.Forks != nil {
+		payload["forks"] = *r.Forks
+	}
+	if r.Timeout != nil {
+		payload["timeout"] = *r.Timeout
+	}
+	if r.JobSliceCount != nil {
+		payload["job_slice_count"] = *r.JobSliceCount
+	}
+	if r.InstanceGroups != nil {
+		payload["instance_groups"] = r.InstanceGroups
+	}
+	if r.Labels != nil {
+		payload["labels"] = r.Labels
+	}
+
+	return payload, nil
+}
+
+// Validate checks the request against the launch info of the template.
+// It returns a *LaunchValidationError describing every problem found.
+func (r *LaunchRequest) Validate(info *LaunchInfo) error {
+	verr := &LaunchValidationError{
+		Invalid: map[string]string{},
+	}
+
+	ignore := func(set bool, asked bool, field string) {
+		if set && !asked {
+			verr.Ignored = append(verr.Ignored, field)
+		}
+	}
+
+	hasExtraVars := r.ExtraVars != nil || r.ExtraVarsYAML != ""
+	ignore(hasExtraVars, info.AskVariablesOnLaunch || info.SurveyEnabled, "extra_vars")
+	ignore(r.Inventory != 0, info.AskInventoryOnLaunch, "inventory")
+	ignore(r.Limit != "", info.AskLimitOnLaunch, "limit")
+	ignore(r.JobTags != "", info.AskTagsOnLaunch, "job_tags")
+	ignore(r.SkipTags != "", info.AskSkipTagsOnLaunch, "skip_tags")
+	ignore(r.JobType != "", info.AskJobTypeOnLaunch, "job_type")
+	ignore(r.Verbosity != nil, info.AskVerbosityOnLaunch, "verbosity")
+	ignore(r.DiffMode != nil, info.AskDiffModeOnLaunch, "diff_mode")
+	ignore(r.Credentials != nil, info.AskCredentialOnLaunch, "credentials")
+	ignore(r.ScmBranch != "", info.AskScmBranchOnLaunch, "scm_branch")
+	ignore(r.ExecutionEnvironment != 0, info.AskExecutionEnvironmentOnLaunch, "execution_environment")
+	ignore(r.Forks != nil, info.AskForksOnLaunch, "forks")
+	ignore(r.Timeout != nil, info.AskTimeoutOnLaunch, "timeout")
+	ignore(r.JobSliceCount != nil, info.AskJobSliceCountOnLaunch, "job_slice_count")
+	ignore(r.InstanceGroups != nil, info.AskInstanceGroupsOnLaunch, "instance_groups")
+	ignore(r.Labels != nil, info.AskLabelsOnLaunch, "labels")
+
+	if info.InventoryNeededToStart && r.Inventory == 0 {
+		verr.Missing = append(verr.Missing, "inventory")
+	}
+	if info.CredentialNeededToStart && len(r.Credentials) == 0 {
+		verr.Missing = append(verr.Missing, "credentials")
+	}
+	for _, password := range info.PasswordsNeededToStart {
+		if _, ok := r.CredentialPasswords[password]; !ok {
+			verr.Missing = append(verr.Missing, "credential_passwords."+password)
+		}
+	}
+	// Only a map can be checked, yaml is left to the server.
+	if r.ExtraVarsYAML == "" {
+		for _, variable := range info.VariablesNeededToStart {
+			if _, ok := r.ExtraVars[variable]; !ok {
+				verr.Missing = append(verr.Missing, "extra_vars."+variable)
+			}
+		}
+	}
+
+	if len(info.NodeTemplatesMissing) > 0 {
+		verr.Invalid["workflow_nodes"] = fmt.Sprintf("nodes %v miss their templates", info.NodeTemplatesMissing)
+	}
+	if r.ExtraVars != nil && r.ExtraVarsYAML != "" {
+		verr.Invalid["extra_vars"] = "set both as map and as yaml"
+	}
+	if r.JobType != "" && r.JobType != JobTypeRun && r.JobType != JobTypeCheck {
+		verr.Invalid["job_type"] = fmt.Sprintf("must be %s or %s", JobTypeRun, JobTypeCheck)
+	}
+	if r.Verbosity != nil && (*r.Verbosity < 0 || *r.Verbosity > 5) {
+		verr.Invalid["verbosity"] = "must be from 0 to 5"
+	}
+	if r.Forks != nil && *r.Forks < 0 {
+		verr.Invalid["forks"] = "must not be negative"
+	}
+	if r.Timeout != nil && *r.Timeout < 0 {
+		verr.Invalid["timeout"] = "must not be negative"
+	}
+	if r.JobSliceCount != nil && *r.JobSliceCount < 1 {
+		verr.Invalid["job_slice_count"] = "must be positive"
+	}
+
+	if len(verr.Ignored) == 0 && len(verr.Missing) == 0 && len(verr.Invalid) == 0 {
+		return nil
+	}
+
+	return verr
+}
+
+// ValidateWithSurvey checks the request against the launch info like Validate,
+// and the extra vars against the survey spec, spec may be nil when the survey is disabled.
+// The survey decides about the variables it asks for, awx lists every required one
+// in VariablesNeededToStart, even those with a default.
+// Missing answers are reported in Missing and other problems in Invalid as `extra_vars.<variable>`.
+// Yaml extra vars are left to the server.
+func (r *LaunchRequest) ValidateWithSurvey(info *LaunchInfo, spec *SurveySpec) error {
+	err := r.Validate(info)
+	if spec == nil || r.ExtraVarsYAML != "" {
+		return err
+	}
+
+	verr, ok := err.(*LaunchValidationError)
+	if !ok {
+		verr = &LaunchValidationError{
+			Invalid: map[string]string{},
+		}
+	}
+
+	missing := make([]string, 0, len(verr.Missing))
+	for _, field := range verr.Missing {
+		variable := strings.TrimPrefix(field, "extra_vars.")
+		if _, asked := spec.Question(variable); asked && variable != field {
+			continue
+		}
+		missing = append(missing, field)
+	}
+	verr.Missing = missing
+
+	var surveyErr *SurveyValidationError
+	if errors.As(spec.Validate(r.ExtraVars), &surveyErr) {
+		variables := make([]string, 0, len(surveyErr.Errors))
+		for variable := range surveyErr.Errors {
+			variables = append(variables, variable)
+		}
+		sort.Strings(variables)
+
+		for _, variable := range variables {
+			problem := surveyErr.Errors[variable]
+			if problem == surveyAnswerRequired {
+				verr.Missing = append(verr.Missing, "extra_vars."+variable)
+			} else {
+				verr.Invalid["extra_vars."+variable] = problem
+			}
+		}
+	}
+
+	if len(verr.Ignored) == 0 && len(verr.Missing) == 0 && len(verr.Invalid) == 0 {
+		return nil
+	}
+
+	return verr
+}
@@ -0,0 +1,303 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// unifiedJobEndpoints maps unified job types to their api collections.
+var unifiedJobEndpoints = map[string]string{
+	UnifiedJobTypeJob:              "jobs",
+	UnifiedJobTypeProjectUpdate:    "project_updates",
+	UnifiedJobTypeInventoryUpdate:  "inventory_updates",
+	UnifiedJobTypeWorkflowJob:      "workflow_jobs",
+	UnifiedJobTypeWorkflowApproval: "workflow_approvals",
+	UnifiedJobTypeAdHocCommand:     "ad_hoc_commands",
+	UnifiedJobTypeSystemJob:        "system_jobs",
+}
+
+// LaunchAnyOptions configures LaunchAny.
+type LaunchAnyOptions struct {
+	// Request is sent to job and workflow job templates.
+	// Projects and inventory sources take no launch parameters, a request is rejected for them.
+	Request *LaunchRequest
+	// Validate checks Request against the launch info of the template before launching,
+	// see JobTemplateService.LaunchWithRequest.
+	Validate bool
+}
+
+// UnifiedJobHandle refers to a job of any type, it is waited on, cancelled
+// and inspected the same way regardless of the underlying job type.
+type UnifiedJobHandle struct {
+	// Type is one of UnifiedJobType constants.
+	Type string
+	ID   int
+	// Template is the ID of the unified job template the job was launched from, if known.
+	Template int
+	// IgnoredFields are the launch fields awx dropped, filled by LaunchAny for job and workflow job templates.
+	IgnoredFields map[string]interface{}
+
+	requester *Requester
+}
+
+// Handle returns the handle of an existing job, e.g. of a UnifiedJob from a list.
+func (u *UnifiedJobService) Handle(jobType string, id int) *UnifiedJobHandle {
+	return &UnifiedJobHandle{
+		Type:      jobType,
+		ID:        id,
+		requester: u.Requester,
+	}
+}
+
+// LaunchAny starts a job from a unified job template of any launchable type:
+// job templates and workflow job templates are launched, projects and inventory sources are updated.
+//
+//	handle, err := client.UnifiedJobService.LaunchAny(ctx, 42, &awx.LaunchAnyOptions{
+//		Request: &awx.LaunchRequest{Limit: "web"},
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	job, err := handle.Wait(ctx, nil)
+func (u *UnifiedJobService) LaunchAny(ctx context.Context, ujtID int, opts *LaunchAnyOptions) (*UnifiedJobHandle, error) {
+	if opts == nil {
+		opts = &LaunchAnyOptions{}
+	}
+
+	template, err := u.GetUnifiedJobTemplate(ctx, ujtID)
+	if err != nil {
+		return nil, err
+	}
+
+	handle := &UnifiedJobHandle{
+		Template:  ujtID,
+		requester: u.Requester,
+	}
+
+	switch template.Type {
+	case UnifiedJobTemplateTypeJobTemplate:
+		service := &JobTemplateService{Requester: u.Requester}
+
+		var launch *JobLaunch
+		switch {
+		case opts.Request == nil:
+			launch, err = service.Launch(ctx, ujtID, map[string]interface{}{})
+		case opts.Validate:
+			launch, err = service.LaunchWithRequest(ctx, ujtID, opts.Request)
+		default:
+			var data map[string]interface{}
+			if data, err = opts.Request.Payload(); err == nil {
+				launch, err = service.Launch(ctx, ujtID, data)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		handle.Type = UnifiedJobTypeJob
+		handle.ID = launch.Job
+		handle.IgnoredFields = launch.IgnoredFields
+	case UnifiedJobTemplateTypeWorkflowJobTemplate:
+		service := &WorkflowJobTemplateService{Requester: u.Requester}
+
+		var launch *WorkflowJobLaunch
+		switch {
+		case opts.Request == nil:
+			launch, err = service.Launch(ctx, ujtID, map[string]interface{}{})
+		case opts.Validate:
+			launch, err = service.LaunchWithRequest(ctx, ujtID, opts.Request)
+		default:
+			var data map[string]interface{}
+			if data, err = opts.Request.Payload(); err == nil {
+				launch, err = service.Launch(ctx, ujtID, data)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		handle.Type = UnifiedJobTypeWorkflowJob
+		handle.ID = launch.WorkflowJob
+		handle.IgnoredFields = launch.IgnoredFields
+	case UnifiedJobTemplateTypeProject, UnifiedJobTemplateTypeInventorySource:
+		if opts.Request != nil {
+			return nil, fmt.Errorf("%s %d takes no launch request", template.Type, ujtID)
+		}
+
+		if err := handle.update(ctx, template.Type); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%s %d can not be launched", template.Type, ujtID)
+	}
+
+	return handle, nil
+}
+
+// update starts an update of the project or inventory source of the handle template.
+func (h *UnifiedJobHandle) update(ctx context.Context, templateType string) error {
+	switch templateType {
+	case UnifiedJobTemplateTypeProject:
+		update, err := (&ProjectService{Requester: h.requester}).SyncProject(ctx, h.Template)
+		if err != nil {
+			return err
+		}
+
+		h.Type = UnifiedJobTypeProjectUpdate
+		h.ID = update.ProjectUpdate
+	case UnifiedJobTemplateTypeInventorySource:
+		update, err := (&InventorySourceService{Requester: h.requester}).SyncInventorySource(ctx, h.Template)
+		if err != nil {
+			return err
+		}
+
+		h.Type = UnifiedJobTypeInventoryUpdate
+		h.ID = update.InventoryUpdate
+	default:
+		return fmt.Errorf("%s %d can not be updated", templateType, h.Template)
+	}
+
+	return nil
+}
+
+// endpoint returns the detail endpoint of the job, suffix is appended to it.
+func (h *UnifiedJobHandle) endpoint(suffix string) (string, error) {
+	collection, ok := unifiedJobEndpoints[h.Type]
+	if !ok {
+		return "", fmt.Errorf("unknown unified job type %q", h.Type)
+	}
+	if h.ID == 0 {
+		return "", errors.New("invalid job id 0")
+	}
+
+	return fmt.Sprintf("/api/v2/%s/%d/%s", collection, h.ID, suffix), nil
+}
+
+// Get shows the details of the job, the concrete job is in UnifiedJob.Value.
+func (h *UnifiedJobHandle) Get(ctx context.Context) (*UnifiedJob, error) {
+	result := UnifiedJob{}
+	endpoint, err := h.endpoint("")
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = h.requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Wait polls the job until it reaches one of the finished statuses.
+// A *JobFailedError is returned along with the job when it is failed, error or canceled.
+// The wait is bounded by ctx only, use context.WithTimeout to limit it.
+func (h *UnifiedJobHandle) Wait(ctx context.Context, opts *WaitOptions) (*UnifiedJob, error) {
+	return waitFor(ctx, h.ID, opts, func(ctx context.Context) (*UnifiedJob, jobState, error) {
+		job, err := h.Get(ctx)
+		if err != nil {
+			return nil, jobState{}, err
+		}
+
+		return job, jobState{
+			Type:            h.Type,
+			Status:          job.Status,
+			JobExplanation:  job.JobExplanation,
+			ResultTraceback: job.ResultTraceback,
+		}, nil
+	})
+}
+
+// Cancel cancels the job.
+func (h *UnifiedJobHandle) Cancel(ctx context.Context) error {
+	endpoint, err := h.endpoint("cancel/")
+	if err != nil {
+		return err
+	}
+
+	_, err = h.requester.Post(ctx, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Relaunch runs the job again and returns the handle of the new one.
+// Jobs, workflow jobs and ad hoc commands are relaunched with data,
+// project and inventory updates are started again from their template.
+func (h *UnifiedJobHandle) Relaunch(ctx context.Context, data map[string]interface{}) (*UnifiedJobHandle, error) {
+	switch h.Type {
+	case UnifiedJobTypeProjectUpdate, UnifiedJobTypeInventoryUpdate:
+		return h.updateAgain(ctx)
+	case UnifiedJobTypeJob, UnifiedJobTypeWorkflowJob, UnifiedJobTypeAdHocCommand:
+	default:
+		return nil, fmt.Errorf("%s %d can not be relaunched", h.Type, h.ID)
+	}
+
+	result := UnifiedJob{}
+	endpoint, err := h.endpoint("relaunch/")
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	_, err = h.requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UnifiedJobHandle{
+		Type:      h.Type,
+		ID:        result.ID,
+		Template:  h.Template,
+		requester: h.requester,
+	}, nil
+}
+
+// updateAgain starts a new project or inventory update from the template of the handle.
+func (h *UnifiedJobHandle) updateAgain(ctx context.Context) (*UnifiedJobHandle, error) {
+	handle := &UnifiedJobHandle{
+		Template:  h.Template,
+		requester: h.requester,
+	}
+
+	if handle.Template == 0 {
+		job, err := h.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		handle.Template = job.UnifiedJobTemplate
+	}
+
+	templateType := UnifiedJobTemplateTypeProject
+	if h.Type == UnifiedJobTypeInventoryUpdate {
+		templateType = UnifiedJobTemplateTypeInventorySource
+	}
+
+	if err := handle.update(ctx, templateType); err != nil {
+		return nil, err
+	}
+
+	return handle, nil
+}
+
+// Stdout shows the output of the job in txt, ansi, json or html format.
+// Workflow jobs and approvals have no output.
+func (h *UnifiedJobHandle) Stdout(ctx context.Context, format string, opts *StdoutOptions) ([]byte, error) {
+	switch h.Type {
+	case UnifiedJobTypeWorkflowJob, UnifiedJobTypeWorkflowApproval:
+		return nil, fmt.Errorf("%s %d has no output", h.Type, h.ID)
+	}
+
+	endpoint, err := h.endpoint("stdout/")
+	if err != nil {
+		return nil, err
+	}
+
+	return getStdout(ctx, h.requester, endpoint, format, opts)
+}
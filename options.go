@@ -0,0 +1,261 @@
+package awx
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option configures a Client created by New.
+type Option func(*clientConfig) error
+
+type clientConfig struct {
+	auth       IAuth
+	httpClient *http.Client
+	tlsConfig  *tls.Config
+	caCerts    [][]byte
+	insecure   bool
+	proxy      *url.URL
+	timeout    time.Duration
+	headers    http.Header
+	retry      *RetryPolicy
+	rateLimit  float64
+	burst      int
+	inFlight   int
+}
+
+// WithAuth sets a custom authorization.
+func WithAuth(auth IAuth) Option {
+	return func(c *clientConfig) error {
+		c.auth = auth
+		return nil
+	}
+}
+
+// WithBasicAuth authorizes requests by login and password.
+func WithBasicAuth(username string, password string) Option {
+	return WithAuth(&BasicAuth{
+		Username: username,
+		Password: password,
+	})
+}
+
+// WithToken authorizes requests by an oauth2 token.
+func WithToken(token string) Option {
+	return WithAuth(&TokenAuth{
+		Token: token,
+	})
+}
+
+// WithHTTPClient replaces http.DefaultClient.
+// The client is copied when combined with the tls, proxy or timeout options.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *clientConfig) error {
+		if httpClient == nil {
+			return errors.New("http client is nil")
+		}
+		c.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTLSConfig sets the tls configuration of the transport.
+// WithCACert and WithInsecureSkipVerify are applied on top of it regardless of the option order.
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(c *clientConfig) error {
+		if tlsConfig == nil {
+			return errors.New("tls config is nil")
+		}
+		c.tlsConfig = tlsConfig.Clone()
+		return nil
+	}
+}
+
+// WithCACert trusts the PEM encoded certificates in addition to the system pool,
+// or to the RootCAs of WithTLSConfig when it sets them.
+func WithCACert(pem []byte) Option {
+	return func(c *clientConfig) error {
+		if !x509.NewCertPool().AppendCertsFromPEM(pem) {
+			return errors.New("no certificates found in ca pem")
+		}
+
+		c.caCerts = append(c.caCerts, pem)
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify disables verification of the server certificate.
+// Use it only for lab installations with self-signed certificates.
+func WithInsecureSkipVerify() Option {
+	return func(c *clientConfig) error {
+		c.insecure = true
+		return nil
+	}
+}
+
+// WithProxy sends every request through the proxy.
+func WithProxy(proxyURL string) Option {
+	return func(c *clientConfig) error {
+		parsed, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy url: %v", err)
+		}
+		c.proxy = parsed
+		return nil
+	}
+}
+
+// WithTimeout limits the time of a single http request.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *clientConfig) error {
+		c.timeout = timeout
+		return nil
+	}
+}
+
+// WithUserAgent sets the `User-Agent` header of every request.
+func WithUserAgent(userAgent string) Option {
+	return WithHeader("User-Agent", userAgent)
+}
+
+// WithHeader adds a header sent with every request.
+func WithHeader(key string, value string) Option {
+	return func(c *clientConfig) error {
+		c.headers.Add(key, value)
+		return nil
+	}
+}
+
+// WithHeaders adds headers sent with every request.
+func WithHeaders(headers http.Header) Option {
+	return func(c *clientConfig) error {
+		for key, values := range headers {
+			for _, value := range values {
+				c.headers.Add(key, value)
+			}
+		}
+		return nil
+	}
+}
+
+// WithRetryPolicy enables retries of failed requests.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *clientConfig) error {
+		c.retry = policy
+		return nil
+	}
+}
+
+// WithRateLimit limits the client to rate requests per second with bursts of up to burst requests.
+// Retries count as requests, waiting is bound to the request context.
+func WithRateLimit(rate float64, burst int) Option {
+	return func(c *clientConfig) error {
+		if rate < 0 {
+			return errors.New("rate limit must not be negative")
+		}
+		c.rateLimit = rate
+		c.burst = burst
+		return nil
+	}
+}
+
+// WithMaxInFlight limits the number of requests the client sends at once.
+func WithMaxInFlight(n int) Option {
+	return func(c *clientConfig) error {
+		if n < 0 {
+			return errors.New("max in-flight requests must not be negative")
+		}
+		c.inFlight = n
+		return nil
+	}
+}
+
+func (c *clientConfig) buildLimiter() *Limiter {
+	if c.rateLimit == 0 && c.inFlight == 0 {
+		return nil
+	}
+
+	return NewLimiter(c.rateLimit, c.burst, c.inFlight)
+}
+
+// buildTLSConfig merges the ca certificates and the insecure flag into the tls configuration.
+func (c *clientConfig) buildTLSConfig() *tls.Config {
+	if c.tlsConfig == nil && len(c.caCerts) == 0 && !c.insecure {
+		return nil
+	}
+
+	tlsConfig := &tls.Config{}
+	if c.tlsConfig != nil {
+		tlsConfig = c.tlsConfig.Clone()
+	}
+
+	if len(c.caCerts) > 0 {
+		// The pool of the supplied config is shared with the caller, so it is copied.
+		pool := tlsConfig.RootCAs
+		if pool == nil {
+			var err error
+			if pool, err = x509.SystemCertPool(); err != nil {
+				pool = x509.NewCertPool()
+			}
+		} else {
+			pool = pool.Clone()
+		}
+
+		for _, pem := range c.caCerts {
+			pool.AppendCertsFromPEM(pem)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.insecure {
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	return tlsConfig
+}
+
+func (c *clientConfig) buildHTTPClient() (*http.Client, error) {
+	httpClient := http.DefaultClient
+	if c.httpClient != nil {
+		httpClient = c.httpClient
+	}
+
+	tlsConfig := c.buildTLSConfig()
+	if tlsConfig == nil && c.proxy == nil && c.timeout == 0 {
+		return httpClient, nil
+	}
+
+	copied := *httpClient
+
+	if tlsConfig != nil || c.proxy != nil {
+		base := copied.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+
+		transport, ok := base.(*http.Transport)
+		if !ok {
+			return nil, fmt.Errorf("tls and proxy options require *http.Transport, got %T", base)
+		}
+
+		transport = transport.Clone()
+		if tlsConfig != nil {
+			transport.TLSClientConfig = tlsConfig
+		}
+		if c.proxy != nil {
+			transport.Proxy = http.ProxyURL(c.proxy)
+		}
+
+		copied.Transport = transport
+	}
+
+	if c.timeout > 0 {
+		copied.Timeout = c.timeout
+	}
+
+	return &copied, nil
+}
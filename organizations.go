@@ -1,14 +1,17 @@
 package awx
 
-import "context"
+import (
+	"context"
+	"fmt"
+)
 
-// OrganizationsService implements awx groups apis.
+// OrganizationsService implements awx organizations apis.
 type OrganizationsService struct {
 	Requester *Requester
 }
 
-// Create shows list of awx groups.
-func (i *OrganizationsService) List(ctx context.Context, params map[string]string) (*ListOrganizations, error) {
+// List shows list of awx organizations.
+func (i *OrganizationsService) List(ctx context.Context, params ListParams) (*ListOrganizations, error) {
 	result := ListOrganizations{}
 	endpoint := "/api/v2/organizations/"
 
@@ -20,21 +23,264 @@ func (i *OrganizationsService) List(ctx context.Context, params map[string]strin
 	return &result, nil
 }
 
-// Create creates an awx group.
-// func (i *OrganizationsService) Create(ctx context.Context, data map[string]interface{}) (*Inventory, error) {
-// 	result := Organization{}
-// 	endpoint := "/api/v2/organizations/"
+// Iter returns an iterator over all awx organizations, following pagination.
+func (i *OrganizationsService) Iter(params ListParams, opts *ListOptions) *Iterator[*Organization] {
+	return newIterator[*Organization](i.Requester, "/api/v2/organizations/", params, opts)
+}
+
+// ListAll shows list of awx organizations from every page.
+func (i *OrganizationsService) ListAll(ctx context.Context, params ListParams, opts *ListOptions) ([]*Organization, error) {
+	return i.Iter(params, opts).All(ctx)
+}
+
+// Get retrives the organization information from its ID.
+func (i *OrganizationsService) Get(ctx context.Context, id int) (*Organization, error) {
+	result := Organization{}
+	endpoint := fmt.Sprintf("/api/v2/organizations/%d/", id)
+
+	_, err := i.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Create creates an awx organization.
+//
+//	name TEXT *REQUIRED
+//	description TEXT
+//	max_hosts INTEGER
+//	default_environment ID
+func (i *OrganizationsService) Create(ctx context.Context, data map[string]interface{}) (*Organization, error) {
+	result := Organization{}
+	endpoint := "/api/v2/organizations/"
+
+	validate, status := ValidateParams(data, []string{"name"})
+	if !status {
+		return nil, fmt.Errorf("mandatory input arguments are absent: %s", validate)
+	}
+
+	_, err := i.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Update update an awx organization.
+func (i *OrganizationsService) Update(ctx context.Context, id int, data map[string]interface{}) (*Organization, error) {
+	result := Organization{}
+	endpoint := fmt.Sprintf("/api/v2/organizations/%d", id)
+
+	_, err := i.Requester.Patch(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Delete delete an awx organization.
+func (i *OrganizationsService) Delete(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/organizations/%d", id)
+
+	_, err := i.Requester.Delete(ctx, endpoint)
+	if err != nil {
+		return err
+	}
 
-// 	validate, status := ValidateParams(data, []string{"name"})
-// 	if !status {
-// 		return nil, fmt.Errorf("mandatory input arguments are absent: %s", validate)
-// 	}
+	return nil
+}
+
+// SetMaxHosts limits the number of hosts the organization may manage, zero means no limit.
+func (i *OrganizationsService) SetMaxHosts(ctx context.Context, id int, maxHosts int) (*Organization, error) {
+	return i.Update(ctx, id, map[string]interface{}{
+		"max_hosts": maxHosts,
+	})
+}
+
+// SetDefaultEnvironment sets the execution environment used by the organization resources
+// which have none, zero resets it.
+func (i *OrganizationsService) SetDefaultEnvironment(ctx context.Context, id int, executionEnvironment int) (*Organization, error) {
+	var value interface{}
+	if executionEnvironment != 0 {
+		value = executionEnvironment
+	}
+
+	return i.Update(ctx, id, map[string]interface{}{
+		"default_environment": value,
+	})
+}
+
+// ListInventories shows the inventories of the organization.
+func (i *OrganizationsService) ListInventories(ctx context.Context, id int, params ListParams) (*ListInventories, error) {
+	result := ListInventories{}
+	endpoint := fmt.Sprintf("/api/v2/organizations/%d/inventories/", id)
+
+	_, err := i.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListProjects shows the projects of the organization.
+func (i *OrganizationsService) ListProjects(ctx context.Context, id int, params ListParams) (*ListProjects, error) {
+	result := ListProjects{}
+	endpoint := fmt.Sprintf("/api/v2/organizations/%d/projects/", id)
+
+	_, err := i.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListJobTemplates shows the job templates of the organization.
+func (i *OrganizationsService) ListJobTemplates(ctx context.Context, id int, params ListParams) (*ListJobTemplates, error) {
+	result := ListJobTemplates{}
+	endpoint := fmt.Sprintf("/api/v2/organizations/%d/job_templates/", id)
+
+	_, err := i.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
 
-// 	// Add check if inventory exists and return proper error
-// 	_, err := i.Requester.Post(ctx, endpoint, data, &result)
-// 	if err != nil {
-// 		return nil, err
-// 	}
+// ListWorkflowJobTemplates shows the workflow job templates of the organization.
+func (i *OrganizationsService) ListWorkflowJobTemplates(ctx context.Context, id int, params ListParams) (*ListWorkflowJobTemplates, error) {
+	result := ListWorkflowJobTemplates{}
+	endpoint := fmt.Sprintf("/api/v2/organizations/%d/workflow_job_templates/", id)
 
-// 	return &result, nil
-// }
+	_, err := i.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListUsers shows the members of the organization.
+func (i *OrganizationsService) ListUsers(ctx context.Context, id int, params ListParams) (*ListUsers, error) {
+	result := ListUsers{}
+	endpoint := fmt.Sprintf("/api/v2/organizations/%d/users/", id)
+
+	_, err := i.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// AddUser adds the user to the members of the organization.
+func (i *OrganizationsService) AddUser(ctx context.Context, id int, userID int) error {
+	return associate(ctx, i.Requester, fmt.Sprintf("/api/v2/organizations/%d/users/", id), userID, false)
+}
+
+// RemoveUser removes the user from the members of the organization.
+func (i *OrganizationsService) RemoveUser(ctx context.Context, id int, userID int) error {
+	return associate(ctx, i.Requester, fmt.Sprintf("/api/v2/organizations/%d/users/", id), userID, true)
+}
+
+// ListAdmins shows the admins of the organization.
+func (i *OrganizationsService) ListAdmins(ctx context.Context, id int, params ListParams) (*ListUsers, error) {
+	result := ListUsers{}
+	endpoint := fmt.Sprintf("/api/v2/organizations/%d/admins/", id)
+
+	_, err := i.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// AddAdmin makes the user an admin of the organization.
+func (i *OrganizationsService) AddAdmin(ctx context.Context, id int, userID int) error {
+	return associate(ctx, i.Requester, fmt.Sprintf("/api/v2/organizations/%d/admins/", id), userID, false)
+}
+
+// RemoveAdmin removes the user from the admins of the organization.
+func (i *OrganizationsService) RemoveAdmin(ctx context.Context, id int, userID int) error {
+	return associate(ctx, i.Requester, fmt.Sprintf("/api/v2/organizations/%d/admins/", id), userID, true)
+}
+
+// ListTeams shows the teams of the organization.
+func (i *OrganizationsService) ListTeams(ctx context.Context, id int, params ListParams) (*ListTeams, error) {
+	result := ListTeams{}
+	endpoint := fmt.Sprintf("/api/v2/organizations/%d/teams/", id)
+
+	_, err := i.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListGalaxyCredentials shows the galaxy credentials of the organization in the order they are used.
+func (i *OrganizationsService) ListGalaxyCredentials(ctx context.Context, id int, params ListParams) (*ListCredentials, error) {
+	result := ListCredentials{}
+	endpoint := fmt.Sprintf("/api/v2/organizations/%d/galaxy_credentials/", id)
+
+	_, err := i.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// AddGalaxyCredential appends the galaxy credential to the organization.
+func (i *OrganizationsService) AddGalaxyCredential(ctx context.Context, id int, credentialID int) error {
+	return associate(ctx, i.Requester, fmt.Sprintf("/api/v2/organizations/%d/galaxy_credentials/", id), credentialID, false)
+}
+
+// RemoveGalaxyCredential removes the galaxy credential from the organization.
+func (i *OrganizationsService) RemoveGalaxyCredential(ctx context.Context, id int, credentialID int) error {
+	return associate(ctx, i.Requester, fmt.Sprintf("/api/v2/organizations/%d/galaxy_credentials/", id), credentialID, true)
+}
+
+// SetGalaxyCredentials replaces the galaxy credentials of the organization, keeping their order.
+// Credentials matching the start of the list are kept, the rest is detached and the new ones attached.
+// The operation is not atomic, the returned error names the credentials detached
+// and attached before the failure.
+func (i *OrganizationsService) SetGalaxyCredentials(ctx context.Context, id int, credentialIDs []int) error {
+	current, err := newIterator[*Credential](i.Requester, fmt.Sprintf("/api/v2/organizations/%d/galaxy_credentials/", id), map[string]string{}, nil).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	kept := 0
+	for kept < len(current) && kept < len(credentialIDs) && current[kept].ID == credentialIDs[kept] {
+		kept++
+	}
+	if kept == len(current) && kept == len(credentialIDs) {
+		return nil
+	}
+
+	detached := make([]int, 0, len(current)-kept)
+	attached := make([]int, 0, len(credentialIDs)-kept)
+	for _, credential := range current[kept:] {
+		if err := i.RemoveGalaxyCredential(ctx, id, credential.ID); err != nil {
+			return fmt.Errorf("set galaxy credentials of organization %d, detached %v: %w", id, detached, err)
+		}
+		detached = append(detached, credential.ID)
+	}
+
+	for _, credentialID := range credentialIDs[kept:] {
+		if err := i.AddGalaxyCredential(ctx, id, credentialID); err != nil {
+			return fmt.Errorf("set galaxy credentials of organization %d, detached %v, attached %v: %w", id, detached, attached, err)
+		}
+		attached = append(attached, credentialID)
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package awx
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// ListOptions controls how iterators walk through the pages of awx list endpoints.
+type ListOptions struct {
+	// PageSize is sent as `page_size`, zero keeps the server default.
+	PageSize int
+	// MaxItems stops the iteration after that many items, zero means no limit.
+	MaxItems int
+}
+
+// Page represents a single page of an awx list endpoint.
+type Page[T any] struct {
+	Pagination
+	Results []T `json:"results"`
+}
+
+// Iterator walks through every item of an awx list endpoint,
+// following the `next` links returned by the api.
+//
+//	it := client.HostService.HostsIter(params, &awx.ListOptions{PageSize: 200})
+//	for it.Next(ctx) {
+//		host := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	requester *Requester
+	endpoint  string
+	query     Query
+	opts      ListOptions
+
+	page    int
+	done    bool
+	items   []T
+	current T
+	seen    int
+	err     error
+}
+
+// newIterator creates an iterator over the list params, unsupported params fail the first Next.
+func newIterator[T any](requester *Requester, endpoint string, params ListParams, opts *ListOptions) *Iterator[T] {
+	query, err := queryOf(params)
+	it := QueryIter[T](requester, endpoint, query, opts)
+	it.err = err
+
+	return it
+}
+
+// QueryIter returns an iterator over every item of the list endpoint filtered by the query.
+// The iteration starts from the page set in the query, if any.
+func QueryIter[T any](requester *Requester, endpoint string, query Query, opts *ListOptions) *Iterator[T] {
+	it := &Iterator[T]{
+		requester: requester,
+		endpoint:  endpoint,
+		query:     query.Clone(),
+		page:      1,
+	}
+
+	if opts != nil {
+		it.opts = *opts
+	}
+
+	if page, err := strconv.Atoi(url.Values(query).Get("page")); err == nil && page > 0 {
+		it.page = page
+	}
+
+	return it
+}
+
+// Next advances the iterator to the next item, fetching the next page when needed.
+// It returns false when all items are consumed, MaxItems is reached,
+// the context is cancelled or a request failed. Check Err afterwards.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.opts.MaxItems > 0 && it.seen >= it.opts.MaxItems {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	for len(it.items) == 0 {
+		if it.done {
+			return false
+		}
+
+		if err := it.fetch(ctx); err != nil {
+			it.err = err
+			return false
+		}
+	}
+
+	it.current = it.items[0]
+	it.items = it.items[1:]
+	it.seen++
+
+	return true
+}
+
+// Value returns the current item.
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All drains the iterator and returns every remaining item.
+func (it *Iterator[T]) All(ctx context.Context) ([]T, error) {
+	result := make([]T, 0)
+	for it.Next(ctx) {
+		result = append(result, it.Value())
+	}
+
+	if it.err != nil {
+		return nil, it.err
+	}
+
+	return result, nil
+}
+
+func (it *Iterator[T]) fetch(ctx context.Context) error {
+	query := it.query.Clone().Page(it.page)
+	if it.opts.PageSize > 0 {
+		query.PageSize(it.opts.PageSize)
+	}
+
+	result := Page[T]{}
+	_, err := it.requester.GetQuery(ctx, it.endpoint, &result, query)
+	if err != nil {
+		return err
+	}
+
+	it.items = result.Results
+
+	// Guard against a server pointing back to an already visited page.
+	next, ok := result.NextPage()
+	if !ok || next <= it.page {
+		it.done = true
+	} else {
+		it.page = next
+	}
+
+	return nil
+}
+
+// HasNext reports whether the api returned a link to the next page.
+func (p *Pagination) HasNext() bool {
+	return p.Next != nil && *p.Next != ""
+}
+
+// NextPage returns the page number referenced by the `next` link.
+func (p *Pagination) NextPage() (int, bool) {
+	if !p.HasNext() {
+		return 0, false
+	}
+
+	URL, err := url.Parse(*p.Next)
+	if err != nil {
+		return 0, false
+	}
+
+	page, err := strconv.Atoi(URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		return 0, false
+	}
+
+	return page, true
+}
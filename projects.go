@@ -0,0 +1,224 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+)
+
+// ProjectService implements awx projects apis.
+type ProjectService struct {
+	Requester *Requester
+}
+
+// ListProjects shows list of awx projects.
+func (p *ProjectService) ListProjects(ctx context.Context, params ListParams) (*ListProjects, error) {
+	result := ListProjects{}
+	endpoint := "/api/v2/projects/"
+
+	_, err := p.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ProjectsIter returns an iterator over all awx projects, following pagination.
+func (p *ProjectService) ProjectsIter(params ListParams, opts *ListOptions) *Iterator[*Project] {
+	return newIterator[*Project](p.Requester, "/api/v2/projects/", params, opts)
+}
+
+// ListAllProjects shows list of awx projects from every page.
+func (p *ProjectService) ListAllProjects(ctx context.Context, params ListParams, opts *ListOptions) ([]*Project, error) {
+	return p.ProjectsIter(params, opts).All(ctx)
+}
+
+// GetProject retrives the project information from its ID.
+func (p *ProjectService) GetProject(ctx context.Context, id int) (*Project, error) {
+	result := Project{}
+	endpoint := fmt.Sprintf("/api/v2/projects/%d/", id)
+
+	_, err := p.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateProject creates an awx project.
+//
+//	name TEXT *REQUIRED
+//	organization ID *REQUIRED
+//	description TEXT
+//	local_path TEXT
+//	scm_type {,git,svn,insights,archive}
+//	scm_url TEXT
+//	scm_branch TEXT
+//	scm_refspec TEXT
+//	scm_clean BOOLEAN
+//	scm_track_submodules BOOLEAN
+//	scm_delete_on_update BOOLEAN
+//	credential ID
+//	timeout INTEGER
+//	scm_update_on_launch BOOLEAN
+//	scm_update_cache_timeout INTEGER
+//	allow_override BOOLEAN
+//	default_environment ID
+//	signature_validation_credential ID
+func (p *ProjectService) CreateProject(ctx context.Context, data map[string]interface{}) (*Project, error) {
+	result := Project{}
+	endpoint := "/api/v2/projects/"
+
+	validate, status := ValidateParams(data, []string{"name", "organization"})
+	if !status {
+		return nil, fmt.Errorf("mandatory input arguments are absent: %s", validate)
+	}
+
+	_, err := p.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateProject update an awx project.
+func (p *ProjectService) UpdateProject(ctx context.Context, id int, data map[string]interface{}) (*Project, error) {
+	result := Project{}
+	endpoint := fmt.Sprintf("/api/v2/projects/%d", id)
+
+	_, err := p.Requester.Patch(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteProject delete an awx project.
+func (p *ProjectService) DeleteProject(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/projects/%d", id)
+
+	_, err := p.Requester.Delete(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SyncProject starts an scm update of the project.
+// The ID of the started update is returned in ProjectUpdate field.
+func (p *ProjectService) SyncProject(ctx context.Context, id int) (*ProjectUpdate, error) {
+	result := ProjectUpdate{}
+	endpoint := fmt.Sprintf("/api/v2/projects/%d/update/", id)
+
+	_, err := p.Requester.Post(ctx, endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	// The update endpoint answers with the update itself, not all versions fill `project_update`.
+	if result.ProjectUpdate == 0 {
+		result.ProjectUpdate = result.ID
+	}
+
+	return &result, nil
+}
+
+// GetPlaybooks shows list of playbooks available in the project.
+func (p *ProjectService) GetPlaybooks(ctx context.Context, id int) ([]string, error) {
+	result := make([]string, 0)
+	endpoint := fmt.Sprintf("/api/v2/projects/%d/playbooks/", id)
+
+	_, err := p.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListProjectUpdates shows list of awx project updates.
+func (p *ProjectService) ListProjectUpdates(ctx context.Context, params ListParams) (*ListProjectUpdates, error) {
+	result := ListProjectUpdates{}
+	endpoint := "/api/v2/project_updates/"
+
+	_, err := p.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListProjectUpdatesByProjectID shows list of updates of specify project.
+func (p *ProjectService) ListProjectUpdatesByProjectID(ctx context.Context, id int, params ListParams) (*ListProjectUpdates, error) {
+	result := ListProjectUpdates{}
+	endpoint := fmt.Sprintf("/api/v2/projects/%d/project_updates/", id)
+
+	_, err := p.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetProjectUpdate shows the details of a project update.
+func (p *ProjectService) GetProjectUpdate(ctx context.Context, id int) (*ProjectUpdate, error) {
+	result := ProjectUpdate{}
+	endpoint := fmt.Sprintf("/api/v2/project_updates/%d/", id)
+
+	_, err := p.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetProjectUpdateCancel shows whether a project update can be canceled.
+func (p *ProjectService) GetProjectUpdateCancel(ctx context.Context, id int) (*ProjectUpdateCancel, error) {
+	result := ProjectUpdateCancel{}
+	endpoint := fmt.Sprintf("/api/v2/project_updates/%d/cancel/", id)
+
+	_, err := p.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CancelProjectUpdate cancels a project update.
+func (p *ProjectService) CancelProjectUpdate(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/project_updates/%d/cancel/", id)
+
+	_, err := p.Requester.Post(ctx, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WaitProjectUpdate polls the project update until it reaches one of the finished statuses.
+// A *JobFailedError is returned along with the update when it is failed, error or canceled.
+// The wait is bounded by ctx only, use context.WithTimeout to limit it.
+func (p *ProjectService) WaitProjectUpdate(ctx context.Context, id int, opts *WaitOptions) (*ProjectUpdate, error) {
+	return waitFor(ctx, id, opts, func(ctx context.Context) (*ProjectUpdate, jobState, error) {
+		update, err := p.GetProjectUpdate(ctx, id)
+		if err != nil {
+			return nil, jobState{}, err
+		}
+
+		return update, jobState{
+			Type:            "project_update",
+			Status:          update.Status,
+			JobExplanation:  update.JobExplanation,
+			ResultTraceback: update.ResultTraceback,
+		}, nil
+	})
+}
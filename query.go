@@ -0,0 +1,295 @@
+package awx
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Enum of field lookups supported by awx list filters.
+const (
+	LookupExact       = "exact"
+	LookupIExact      = "iexact"
+	LookupContains    = "contains"
+	LookupIContains   = "icontains"
+	LookupStartsWith  = "startswith"
+	LookupIStartsWith = "istartswith"
+	LookupEndsWith    = "endswith"
+	LookupIEndsWith   = "iendswith"
+	LookupRegex       = "regex"
+	LookupIRegex      = "iregex"
+	LookupGT          = "gt"
+	LookupGTE         = "gte"
+	LookupLT          = "lt"
+	LookupLTE         = "lte"
+	LookupIsNull      = "isnull"
+	LookupIn          = "in"
+)
+
+// Query builds the query string of awx list endpoints.
+// Its underlying type is url.Values, so a key may hold several values,
+// e.g. repeated `or__` filters or search terms.
+//
+//	query := awx.NewQuery().
+//		Filter("name", awx.LookupIContains, "web").
+//		Or("inventory__name", awx.LookupExact, "prod").
+//		Or("inventory__name", awx.LookupExact, "stage").
+//		Filter("created", awx.LookupGT, time.Now().Add(-24*time.Hour)).
+//		OrderBy("-created", "name")
+//	hosts, err := client.HostService.ListHosts(ctx, query)
+//
+// Every list method and Requester.Get take it as ListParams.
+type Query url.Values
+
+// ListParams are the query params of list methods and Requester.Get.
+// They are either a Query, url.Values or a map[string]string, nil sends none.
+type ListParams interface{}
+
+// NewQuery creates an empty query.
+func NewQuery() Query {
+	return Query{}
+}
+
+// Set replaces the values of the key.
+func (q Query) Set(key string, value string) Query {
+	url.Values(q).Set(key, value)
+	return q
+}
+
+// Add appends a value to the key, the key is then repeated in the query string.
+func (q Query) Add(key string, value string) Query {
+	url.Values(q).Add(key, value)
+	return q
+}
+
+// Get returns the values of the key.
+func (q Query) Get(key string) []string {
+	return q[key]
+}
+
+// Del removes every value of the key.
+func (q Query) Del(key string) Query {
+	delete(q, key)
+	return q
+}
+
+// Clone returns a copy of the query.
+func (q Query) Clone() Query {
+	result := make(Query, len(q))
+	for key, values := range q {
+		result[key] = append([]string(nil), values...)
+	}
+
+	return result
+}
+
+// Values converts the query to url values.
+func (q Query) Values() url.Values {
+	return url.Values(q.Clone())
+}
+
+// Encode renders the query string sorted by key.
+func (q Query) Encode() string {
+	return url.Values(q).Encode()
+}
+
+// Params converts the query to a plain map, for code that does not take ListParams.
+// It fails when a key has several values, a map can not hold them.
+func (q Query) Params() (map[string]string, error) {
+	result := make(map[string]string, len(q))
+	for key, values := range q {
+		if len(values) > 1 {
+			return nil, fmt.Errorf("query key %q has %d values, params take one", key, len(values))
+		}
+		if len(values) == 1 {
+			result[key] = values[0]
+		}
+	}
+
+	return result, nil
+}
+
+// queryOf converts list params to a query, the params are never modified.
+func queryOf(params ListParams) (Query, error) {
+	switch p := params.(type) {
+	case nil:
+		return Query{}, nil
+	case Query:
+		return p.Clone(), nil
+	case url.Values:
+		return Query(p).Clone(), nil
+	case map[string]string:
+		query := make(Query, len(p))
+		for key, value := range p {
+			query.Set(key, value)
+		}
+		return query, nil
+	}
+
+	// Named map types, e.g. `type Filters map[string]string`, are accepted as well.
+	value := reflect.ValueOf(params)
+	if value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String {
+		switch {
+		case value.Type().Elem().Kind() == reflect.String:
+			query := make(Query, value.Len())
+			for it := value.MapRange(); it.Next(); {
+				query.Set(it.Key().String(), it.Value().String())
+			}
+			return query, nil
+		case value.Type().Elem() == reflect.TypeOf([]string(nil)):
+			return Query(value.Convert(reflect.TypeOf(url.Values(nil))).Interface().(url.Values)).Clone(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported list params %T, use Query or map[string]string", params)
+}
+
+// Where filters the field by exact value, related fields are reached with `__`,
+// e.g. `inventory__name`.
+func (q Query) Where(field string, value interface{}) Query {
+	return q.Add(field, formatQueryValue(value))
+}
+
+// Filter filters the field with the lookup, one of Lookup constants.
+func (q Query) Filter(field string, lookup string, value interface{}) Query {
+	return q.Add(lookupKey(field, lookup), formatQueryValue(value))
+}
+
+// In filters the field by any of the values.
+func (q Query) In(field string, values ...interface{}) Query {
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		formatted = append(formatted, formatQueryValue(value))
+	}
+
+	return q.Add(lookupKey(field, LookupIn), strings.Join(formatted, ","))
+}
+
+// Or adds a filter combined with the other Or filters by OR.
+func (q Query) Or(field string, lookup string, value interface{}) Query {
+	return q.Add("or__"+lookupKey(field, lookup), formatQueryValue(value))
+}
+
+// Not excludes the objects matching the filter.
+func (q Query) Not(field string, lookup string, value interface{}) Query {
+	return q.Add("not__"+lookupKey(field, lookup), formatQueryValue(value))
+}
+
+// Chain adds a filter applied separately, needed for multiple conditions
+// on the same many-to-many relation.
+func (q Query) Chain(field string, lookup string, value interface{}) Query {
+	return q.Add("chain__"+lookupKey(field, lookup), formatQueryValue(value))
+}
+
+// Search adds a full text search on the searchable fields of the endpoint.
+func (q Query) Search(terms ...string) Query {
+	for _, term := range terms {
+		q.Add("search", term)
+	}
+
+	return q
+}
+
+// OrderBy sorts the results, prefix a field with `-` for descending order.
+func (q Query) OrderBy(fields ...string) Query {
+	return q.Set("order_by", strings.Join(fields, ","))
+}
+
+// PageSize sets the number of results per page.
+func (q Query) PageSize(size int) Query {
+	return q.Set("page_size", strconv.Itoa(size))
+}
+
+// Page sets the page to read, iterators start from it.
+func (q Query) Page(page int) Query {
+	return q.Set("page", strconv.Itoa(page))
+}
+
+// HostFilter sets the `host_filter` of host lists and smart inventories,
+// see HostFilterField, HostFilterAnd, HostFilterOr and HostFilterNot.
+func (q Query) HostFilter(expression string) Query {
+	return q.Set("host_filter", expression)
+}
+
+// HostFilterField renders a `field=value` condition of the host filter DSL.
+// Related fields and facts are reached with `__`, e.g. `groups__name`
+// or `ansible_facts__ansible_distribution`.
+func HostFilterField(field string, value interface{}) string {
+	formatted := formatQueryValue(value)
+	if formatted == "" || strings.ContainsAny(formatted, " \t()\"=") {
+		formatted = strconv.Quote(formatted)
+	}
+
+	return field + "=" + formatted
+}
+
+// HostFilterFact renders a condition on an ansible fact of the host.
+func HostFilterFact(fact string, value interface{}) string {
+	return HostFilterField("ansible_facts__"+fact, value)
+}
+
+// HostFilterAnd combines the conditions by AND.
+func HostFilterAnd(expressions ...string) string {
+	return joinHostFilter(expressions, "and")
+}
+
+// HostFilterOr combines the conditions by OR.
+func HostFilterOr(expressions ...string) string {
+	return joinHostFilter(expressions, "or")
+}
+
+// HostFilterNot negates the condition.
+func HostFilterNot(expression string) string {
+	return "not " + groupHostFilter(expression)
+}
+
+func joinHostFilter(expressions []string, operator string) string {
+	parts := make([]string, 0, len(expressions))
+	for _, expression := range expressions {
+		if expression != "" {
+			parts = append(parts, groupHostFilter(expression))
+		}
+	}
+
+	return strings.Join(parts, " "+operator+" ")
+}
+
+// groupHostFilter wraps compound expressions in parentheses to keep their precedence.
+func groupHostFilter(expression string) string {
+	if strings.Contains(expression, " and ") || strings.Contains(expression, " or ") || strings.HasPrefix(expression, "not ") {
+		return "(" + expression + ")"
+	}
+
+	return expression
+}
+
+func lookupKey(field string, lookup string) string {
+	if lookup == "" || lookup == LookupExact {
+		return field
+	}
+
+	return field + "__" + lookup
+}
+
+func formatQueryValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return v.UTC().Format(time.RFC3339)
+	case bool:
+		return strconv.FormatBool(v)
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	return fmt.Sprint(value)
+}
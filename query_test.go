@@ -0,0 +1,247 @@
+package awx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestQueryEncode(t *testing.T) {
+	created := time.Date(2024, 3, 1, 12, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		name  string
+		query Query
+		want  string
+	}{
+		{
+			name:  "empty",
+			query: NewQuery(),
+			want:  "",
+		},
+		{
+			name:  "where",
+			query: NewQuery().Where("inventory__name", "prod").Where("enabled", true),
+			want:  "enabled=true&inventory__name=prod",
+		},
+		{
+			name:  "filter lookups",
+			query: NewQuery().Filter("name", LookupIContains, "web").Filter("id", LookupExact, 3).Filter("id", LookupGT, 1),
+			want:  "id=3&id__gt=1&name__icontains=web",
+		},
+		{
+			name:  "in",
+			query: NewQuery().In("id", 1, 2, 3),
+			want:  "id__in=1%2C2%2C3",
+		},
+		{
+			name:  "or is repeated",
+			query: NewQuery().Or("name", LookupExact, "a").Or("name", LookupExact, "b"),
+			want:  "or__name=a&or__name=b",
+		},
+		{
+			name:  "not and chain",
+			query: NewQuery().Not("status", "", "failed").Chain("groups__name", LookupStartsWith, "web"),
+			want:  "chain__groups__name__startswith=web&not__status=failed",
+		},
+		{
+			name:  "search terms",
+			query: NewQuery().Search("web", "db"),
+			want:  "search=web&search=db",
+		},
+		{
+			name:  "time in utc",
+			query: NewQuery().Filter("created", LookupGTE, created).Filter("modified", LookupLT, &created),
+			want:  "created__gte=2024-03-01T11%3A30%3A00Z&modified__lt=2024-03-01T11%3A30%3A00Z",
+		},
+		{
+			name:  "nil time",
+			query: NewQuery().Filter("finished", LookupLT, (*time.Time)(nil)),
+			want:  "finished__lt=",
+		},
+		{
+			name:  "order and paging replace",
+			query: NewQuery().OrderBy("name").OrderBy("-created", "name").PageSize(50).Page(2).Page(3),
+			want:  "order_by=-created%2Cname&page=3&page_size=50",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.Encode(); got != tt.want {
+				t.Errorf("Encode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   Query
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "single values",
+			query: NewQuery().Where("name", "web").PageSize(10),
+			want:  map[string]string{"name": "web", "page_size": "10"},
+		},
+		{
+			name:    "repeated key",
+			query:   NewQuery().Search("web", "db"),
+			wantErr: true,
+		},
+		{
+			name:  "empty key is dropped",
+			query: Query{"name": nil},
+			want:  map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.query.Params()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Params() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Params() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryOf(t *testing.T) {
+	type filters map[string]string
+
+	tests := []struct {
+		name    string
+		params  ListParams
+		want    string
+		wantErr bool
+	}{
+		{name: "nil", params: nil, want: ""},
+		{name: "map", params: map[string]string{"name": "web", "page_size": "10"}, want: "name=web&page_size=10"},
+		{name: "named map", params: filters{"name": "web"}, want: "name=web"},
+		{name: "query", params: NewQuery().Search("web", "db"), want: "search=web&search=db"},
+		{name: "url values", params: url.Values{"id": {"1", "2"}}, want: "id=1&id=2"},
+		{name: "unsupported", params: []string{"name"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := queryOf(tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("queryOf() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.Encode() != tt.want {
+				t.Errorf("queryOf() = %q, want %q", got.Encode(), tt.want)
+			}
+		})
+	}
+}
+
+func TestListMethodsSendRepeatedKeys(t *testing.T) {
+	queries := make(chan string, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries <- r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count": 0, "results": []}`))
+	}))
+	defer server.Close()
+
+	hosts := &HostService{Requester: &Requester{Base: server.URL, Client: server.Client()}}
+	query := NewQuery().Or("name", LookupExact, "a").Or("name", LookupExact, "b")
+
+	if _, err := hosts.ListHosts(context.Background(), query); err != nil {
+		t.Fatalf("ListHosts() = %v", err)
+	}
+	if got, want := <-queries, "or__name=a&or__name=b"; got != want {
+		t.Errorf("ListHosts() query = %q, want %q", got, want)
+	}
+
+	if _, err := hosts.ListAllHosts(context.Background(), query, nil); err != nil {
+		t.Fatalf("ListAllHosts() = %v", err)
+	}
+	if got, want := <-queries, "or__name=a&or__name=b&page=1"; got != want {
+		t.Errorf("ListAllHosts() query = %q, want %q", got, want)
+	}
+}
+
+func TestQueryCloneIsIndependent(t *testing.T) {
+	query := NewQuery().Where("name", "web")
+	clone := query.Clone().Add("name", "db").Set("page", "2")
+	values := query.Values()
+	values.Add("name", "mail")
+
+	if got := query.Encode(); got != "name=web" {
+		t.Errorf("original query = %q, want %q", got, "name=web")
+	}
+	if got := clone.Encode(); got != "name=web&name=db&page=2" {
+		t.Errorf("clone = %q, want %q", got, "name=web&name=db&page=2")
+	}
+	if got := query.Del("name").Get("name"); got != nil {
+		t.Errorf("Get() after Del() = %v, want nil", got)
+	}
+}
+
+func TestHostFilter(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{
+			name: "field",
+			got:  HostFilterField("name", "web1"),
+			want: "name=web1",
+		},
+		{
+			name: "quoted field",
+			got:  HostFilterField("description", "web server"),
+			want: `description="web server"`,
+		},
+		{
+			name: "empty field",
+			got:  HostFilterField("description", ""),
+			want: `description=""`,
+		},
+		{
+			name: "fact",
+			got:  HostFilterFact("ansible_distribution", "Ubuntu"),
+			want: "ansible_facts__ansible_distribution=Ubuntu",
+		},
+		{
+			name: "and skips empty",
+			got:  HostFilterAnd("name=a", "", "enabled=true"),
+			want: "name=a and enabled=true",
+		},
+		{
+			name: "nested groups",
+			got:  HostFilterAnd(HostFilterOr("name=a", "name=b"), HostFilterNot("enabled=false")),
+			want: "(name=a or name=b) and (not enabled=false)",
+		},
+		{
+			name: "not of compound",
+			got:  HostFilterNot(HostFilterAnd("name=a", "enabled=true")),
+			want: "not (name=a and enabled=true)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+
+	if got := NewQuery().HostFilter("name=a").Encode(); got != "host_filter=name%3Da" {
+		t.Errorf("HostFilter() = %q, want %q", got, "host_filter=name%3Da")
+	}
+}
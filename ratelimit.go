@@ -0,0 +1,121 @@
+package awx
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// tokenBucket is a token bucket rate limiter safe for concurrent use.
+// A nil bucket does not limit anything.
+type tokenBucket struct {
+	mu       sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	last     time.Time
+	interval time.Duration
+}
+
+// newTokenBucket allows rate operations per second with bursts of up to burst operations.
+// It returns nil when rate is not positive.
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if rate <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		rate:     rate,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+		interval: time.Duration(float64(time.Second) / rate),
+	}
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	if b == nil {
+		return ctx.Err()
+	}
+
+	for {
+		delay := b.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token and returns zero, or returns how long to wait for the next one.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) * float64(b.interval))
+}
+
+// Limiter bounds the requests of a Requester by rate and by the number sent at once.
+// It is shared by every service of a client and safe for concurrent use.
+type Limiter struct {
+	bucket *tokenBucket
+	slots  chan struct{}
+}
+
+// NewLimiter allows rate requests per second with bursts of up to burst requests,
+// and at most maxInFlight requests waiting for a response. Zero disables the limit.
+func NewLimiter(rate float64, burst int, maxInFlight int) *Limiter {
+	limiter := &Limiter{
+		bucket: newTokenBucket(rate, burst),
+	}
+
+	if maxInFlight > 0 {
+		limiter.slots = make(chan struct{}, maxInFlight)
+	}
+
+	return limiter
+}
+
+// acquire waits for a free slot and a token, the returned func frees the slot.
+// A nil limiter lets every request through.
+func (l *Limiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	release := func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			release = func() { <-l.slots }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if err := l.bucket.Wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	return release, nil
+}
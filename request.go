@@ -41,7 +41,9 @@ type APIRequest struct {
 	Payload  interface{}
 	Headers  http.Header
 	Query    map[string]string
-	Suffix   string
+	// Values are sent along with Query, they may repeat keys.
+	Values url.Values
+	Suffix string
 }
 
 // SetHeader sets http header by passing k,v.
@@ -72,18 +74,34 @@ func NewAPIRequest(method string, endpoint string, payload interface{}, query ma
 // For production usage, It would be better to wrapper
 // an another rest client on this requester.
 type Requester struct {
-	Base   string
-	Auth   IAuth
-	Client *http.Client
+	Base    string
+	Auth    IAuth
+	Client  *http.Client
+	Retry   *RetryPolicy
+	Headers http.Header
+	// Limiter bounds the rate and concurrency of requests, nil means no limit.
+	Limiter *Limiter
 }
 
-// Get performs http get request.
-func (r *Requester) Get(ctx context.Context, endpoint string, responseStruct any, query map[string]string) (*http.Response, error) {
-	ar := NewAPIRequest(http.MethodGet, endpoint, nil, query)
+// Get performs http get request, the query is a Query or a map[string]string, see ListParams.
+// Pass *[]byte as responseStruct to receive the raw response body.
+func (r *Requester) Get(ctx context.Context, endpoint string, responseStruct any, query ListParams) (*http.Response, error) {
+	values, err := queryOf(query)
+	if err != nil {
+		return nil, err
+	}
+
+	ar := NewAPIRequest(http.MethodGet, endpoint, nil, nil)
+	ar.Values = url.Values(values)
 	ar.Suffix = ""
 	return r.Do(ctx, ar, responseStruct)
 }
 
+// GetQuery performs http get request with a Query, which may repeat keys.
+func (r *Requester) GetQuery(ctx context.Context, endpoint string, responseStruct any, query Query) (*http.Response, error) {
+	return r.Get(ctx, endpoint, responseStruct, query)
+}
+
 // Post performs http post request with json response.
 func (r *Requester) Post(ctx context.Context, endpoint string, payload interface{}, responseStruct interface{}) (*http.Response, error) {
 	ar := NewAPIRequest(http.MethodPost, endpoint, payload, map[string]string{})
@@ -130,9 +148,8 @@ func ValidateParams(data map[string]interface{}, mandatoryFields []string) (notf
 }
 
 // Do do the actual http request.
+// Failed attempts are repeated according to the Retry policy, if set.
 func (r *Requester) Do(ctx context.Context, ar *APIRequest, responseStruct interface{}) (*http.Response, error) {
-	var body io.Reader
-
 	if !strings.HasSuffix(ar.Endpoint, "/") && ar.Method != "POST" {
 		ar.Endpoint += "/"
 	}
@@ -142,60 +159,109 @@ func (r *Requester) Do(ctx context.Context, ar *APIRequest, responseStruct inter
 		return nil, err
 	}
 
-	if ar.Query != nil {
+	if ar.Query != nil || ar.Values != nil {
 		querystring := make(url.Values)
 		for key, val := range ar.Query {
 			querystring.Set(key, val)
 		}
+		for key, vals := range ar.Values {
+			querystring[key] = append(querystring[key], vals...)
+		}
 
 		URL.RawQuery = querystring.Encode()
 	}
 
+	// The payload is rendered once, so every retry sends the same body.
+	var payload []byte
 	if ar.Payload != nil {
-		rendered, err := json.Marshal(ar.Payload)
+		payload, err = json.Marshal(ar.Payload)
 		if err != nil {
 			return nil, err
 		}
+	}
+
+	attempts := r.Retry.attempts()
+	for attempt := 1; ; attempt++ {
+		req, response, bodyBytes, err := r.send(ctx, ar, URL.String(), payload)
+		if req == nil {
+			return nil, err
+		}
+
+		if attempt < attempts && (err != nil || response.StatusCode < 200 || response.StatusCode > 299) &&
+			r.Retry.shouldRetry(req, response, err) {
+			if err := sleepContext(ctx, r.Retry.backoff(attempt, response)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if response.StatusCode < 200 || response.StatusCode > 299 {
+			return nil, newAPIError(req, response, bodyBytes)
+		}
 
-		body = bytes.NewReader(rendered)
+		// Non-json endpoints like stdout are read as is.
+		if raw, ok := responseStruct.(*[]byte); ok {
+			*raw = bodyBytes
+			return response, nil
+		}
+
+		// В методе DELETE не возвращается тело ответа от сервера AWX
+		// По этому необходимо проверить что мы ожидаем это тело получить
+		if len(bodyBytes) > 0 && responseStruct != nil {
+			if err := json.Unmarshal(bodyBytes, &responseStruct); err != nil {
+				return nil, fmt.Errorf("error unmarshal: %v", err)
+			}
+		}
+
+		return response, nil
 	}
+}
 
-	var req *http.Request
-	req, err = http.NewRequestWithContext(ctx, ar.Method, URL.String(), body)
+// send performs a single attempt of the request.
+// The returned request is nil when it could not be built at all.
+func (r *Requester) send(ctx context.Context, ar *APIRequest, URL string, payload []byte) (*http.Request, *http.Response, []byte, error) {
+	release, err := r.Limiter.acquire(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
+	}
+	defer release()
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, ar.Method, URL, body)
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
 	if r.Auth != nil {
 		r.Auth.SetAuthorizationHeader(req)
 	}
 
+	for k, values := range r.Headers {
+		req.Header[k] = append([]string(nil), values...)
+	}
+
 	for k := range ar.Headers {
 		req.Header.Add(k, ar.Headers.Get(k))
 	}
 
 	response, err := r.Client.Do(req)
 	if err != nil {
-		return nil, err
+		return req, nil, nil, err
 	}
 	defer response.Body.Close()
 
 	bodyBytes, err := io.ReadAll(response.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading body: %v", err)
-	}
-
-	if response.StatusCode < 200 || response.StatusCode > 299 {
-		return nil, fmt.Errorf("response code %d, resp: %s", response.StatusCode, string(bodyBytes))
-	}
-
-	// В методе DELETE не возвращается тело ответа от сервера AWX
-	// По этому необходимо проверить что мы ожидаем это тело получить
-	if len(bodyBytes) > 0 && responseStruct != nil {
-		if err := json.Unmarshal(bodyBytes, &responseStruct); err != nil {
-			return nil, fmt.Errorf("error unmarshal: %v", err)
-		}
+		return req, nil, nil, fmt.Errorf("error reading body: %v", err)
 	}
 
-	return response, nil
+	return req, response, bodyBytes, nil
 }
@@ -0,0 +1,155 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy describes how Requester re-sends failed requests.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one,
+	// values below 2 disable retries.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry, doubled on every next one.
+	MinBackoff time.Duration
+	// MaxBackoff caps both the exponential delay and `Retry-After`.
+	MaxBackoff time.Duration
+	// Jitter is the fraction of the delay, from 0 to 1, which is randomized.
+	Jitter float64
+	// ShouldRetry decides whether the attempt should be repeated,
+	// response is nil when the request failed on the transport level.
+	// DefaultShouldRetry is used when nil.
+	ShouldRetry func(req *http.Request, response *http.Response, err error) bool
+}
+
+// RetryableStatusCodes are the status codes retried by DefaultShouldRetry.
+// 409 and 503 are returned by AWX itself during task manager contention,
+// 502 and 504 usually come from a load balancer in front of it.
+var RetryableStatusCodes = []int{
+	http.StatusConflict,
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// DefaultRetryPolicy returns a policy doing up to 4 attempts with a backoff from 500ms to 30s.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.5,
+	}
+}
+
+// DefaultShouldRetry retries transport errors and RetryableStatusCodes of idempotent methods only.
+func DefaultShouldRetry(req *http.Request, response *http.Response, err error) bool {
+	if !IsIdempotentMethod(req.Method) {
+		return false
+	}
+
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	for _, code := range RetryableStatusCodes {
+		if response.StatusCode == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsIdempotentMethod reports whether repeating a request with the method is safe.
+func IsIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+func (p *RetryPolicy) attempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) shouldRetry(req *http.Request, response *http.Response, err error) bool {
+	if p.ShouldRetry != nil {
+		return p.ShouldRetry(req, response, err)
+	}
+
+	return DefaultShouldRetry(req, response, err)
+}
+
+// backoff returns the delay before the given retry, starting from 1.
+func (p *RetryPolicy) backoff(retry int, response *http.Response) time.Duration {
+	if wait, ok := retryAfter(response); ok {
+		if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+			return p.MaxBackoff
+		}
+		return wait
+	}
+
+	wait := float64(p.MinBackoff) * math.Pow(2, float64(retry-1))
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		wait = wait*(1-jitter) + wait*jitter*rand.Float64()
+	}
+
+	return time.Duration(wait)
+}
+
+// retryAfter parses `Retry-After` header in both seconds and http-date forms.
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	header := response.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
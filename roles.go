@@ -0,0 +1,186 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Enum of common role names, an object has only the roles that make sense for it.
+const (
+	RoleAdmin    = "admin"
+	RoleExecute  = "execute"
+	RoleUse      = "use"
+	RoleRead     = "read"
+	RoleUpdate   = "update"
+	RoleAdhoc    = "adhoc"
+	RoleMember   = "member"
+	RoleApproval = "approval"
+	RoleAuditor  = "auditor"
+)
+
+// Enum of resources holding roles, used as path of their endpoints.
+const (
+	ResourceOrganization        = "organizations"
+	ResourceInventory           = "inventories"
+	ResourceProject             = "projects"
+	ResourceJobTemplate         = "job_templates"
+	ResourceWorkflowJobTemplate = "workflow_job_templates"
+	ResourceCredential          = "credentials"
+	ResourceTeam                = "teams"
+)
+
+// RoleService implements awx role apis.
+type RoleService struct {
+	Requester *Requester
+}
+
+// ListRoles shows a list of roles.
+func (r *RoleService) ListRoles(ctx context.Context, params ListParams) (*ListRoles, error) {
+	result := ListRoles{}
+	endpoint := "/api/v2/roles/"
+
+	_, err := r.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RolesIter returns an iterator over all roles, following pagination.
+func (r *RoleService) RolesIter(params ListParams, opts *ListOptions) *Iterator[*Role] {
+	return newIterator[*Role](r.Requester, "/api/v2/roles/", params, opts)
+}
+
+// GetRole retrives the role information from its ID.
+func (r *RoleService) GetRole(ctx context.Context, id int) (*Role, error) {
+	result := Role{}
+	endpoint := fmt.Sprintf("/api/v2/roles/%d/", id)
+
+	_, err := r.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListRoleUsers shows the users holding the role directly.
+func (r *RoleService) ListRoleUsers(ctx context.Context, id int, params ListParams) (*ListUsers, error) {
+	result := ListUsers{}
+	endpoint := fmt.Sprintf("/api/v2/roles/%d/users/", id)
+
+	_, err := r.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListRoleTeams shows the teams holding the role.
+func (r *RoleService) ListRoleTeams(ctx context.Context, id int, params ListParams) (*ListTeams, error) {
+	result := ListTeams{}
+	endpoint := fmt.Sprintf("/api/v2/roles/%d/teams/", id)
+
+	_, err := r.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListObjectRoles shows the roles of an object, resource is one of Resource constants.
+func (r *RoleService) ListObjectRoles(ctx context.Context, resource string, id int, params ListParams) (*ListRoles, error) {
+	result := ListRoles{}
+	endpoint := fmt.Sprintf("/api/v2/%s/%d/object_roles/", resource, id)
+
+	_, err := r.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetObjectRole finds the role of an object by its name, e.g. RoleExecute or "execute_role".
+func (r *RoleService) GetObjectRole(ctx context.Context, resource string, id int, name string) (*ApplyRole, error) {
+	result := struct {
+		SummaryFields struct {
+			ObjectRoles map[string]*ApplyRole `json:"object_roles"`
+		} `json:"summary_fields"`
+	}{}
+	endpoint := fmt.Sprintf("/api/v2/%s/%d/", resource, id)
+
+	_, err := r.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	key := strings.ToLower(name)
+	if !strings.HasSuffix(key, "_role") {
+		key += "_role"
+	}
+
+	role, ok := result.SummaryFields.ObjectRoles[key]
+	if !ok || role == nil {
+		available := make([]string, 0, len(result.SummaryFields.ObjectRoles))
+		for roleName := range result.SummaryFields.ObjectRoles {
+			available = append(available, strings.TrimSuffix(roleName, "_role"))
+		}
+		sort.Strings(available)
+
+		return nil, fmt.Errorf("%s %d has no %s role, available: %s", resource, id, name, strings.Join(available, ", "))
+	}
+
+	return role, nil
+}
+
+// GrantUserRole grants the named role on an object to a user.
+func (r *RoleService) GrantUserRole(ctx context.Context, userID int, resource string, id int, name string) error {
+	return r.changeRole(ctx, fmt.Sprintf("/api/v2/users/%d/roles/", userID), resource, id, name, false)
+}
+
+// RevokeUserRole revokes the named role on an object from a user.
+func (r *RoleService) RevokeUserRole(ctx context.Context, userID int, resource string, id int, name string) error {
+	return r.changeRole(ctx, fmt.Sprintf("/api/v2/users/%d/roles/", userID), resource, id, name, true)
+}
+
+// GrantTeamRole grants the named role on an object to every member of a team.
+func (r *RoleService) GrantTeamRole(ctx context.Context, teamID int, resource string, id int, name string) error {
+	return r.changeRole(ctx, fmt.Sprintf("/api/v2/teams/%d/roles/", teamID), resource, id, name, false)
+}
+
+// RevokeTeamRole revokes the named role on an object from a team.
+func (r *RoleService) RevokeTeamRole(ctx context.Context, teamID int, resource string, id int, name string) error {
+	return r.changeRole(ctx, fmt.Sprintf("/api/v2/teams/%d/roles/", teamID), resource, id, name, true)
+}
+
+func (r *RoleService) changeRole(ctx context.Context, endpoint string, resource string, id int, name string, disassociate bool) error {
+	role, err := r.GetObjectRole(ctx, resource, id, name)
+	if err != nil {
+		return err
+	}
+
+	return associate(ctx, r.Requester, endpoint, role.ID, disassociate)
+}
+
+// associate adds or removes the related object on a sublist endpoint.
+func associate(ctx context.Context, requester *Requester, endpoint string, id int, disassociate bool) error {
+	payload := map[string]interface{}{
+		"id": id,
+	}
+	if disassociate {
+		payload["disassociate"] = true
+	}
+
+	_, err := requester.Post(ctx, endpoint, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,324 @@
+package awx
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Enum of recurrence frequencies accepted by awx.
+const (
+	FrequencyMinutely = "MINUTELY"
+	FrequencyHourly   = "HOURLY"
+	FrequencyDaily    = "DAILY"
+	FrequencyWeekly   = "WEEKLY"
+	FrequencyMonthly  = "MONTHLY"
+	FrequencyYearly   = "YEARLY"
+)
+
+const (
+	rruleDateLayout    = "20060102T150405"
+	rruleUTCDateLayout = "20060102T150405Z"
+)
+
+var rruleWeekdays = map[time.Weekday]string{
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+	time.Sunday:    "SU",
+}
+
+// RRule builds the recurrence rule of a schedule in the format awx accepts.
+//
+//	rule := awx.NewRRule(start, awx.FrequencyWeekly).
+//		Every(2).
+//		OnDays(time.Monday, time.Thursday).
+//		Times(10).
+//		Exclude(awx.NewRRule(time.Time{}, awx.FrequencyYearly).InMonths(12).OnMonthDays(25))
+//
+// The timezone of the start time is sent as TZID, so it must be loaded
+// with time.LoadLocation or be UTC.
+type RRule struct {
+	start      time.Time
+	freq       string
+	interval   int
+	days       []time.Weekday
+	monthDays  []int
+	months     []int
+	hours      []int
+	minutes    []int
+	count      int
+	until      time.Time
+	exclusions []*RRule
+}
+
+// NewRRule starts a rule recurring with freq from start.
+func NewRRule(start time.Time, freq string) *RRule {
+	return &RRule{
+		start: start,
+		freq:  freq,
+	}
+}
+
+// Every sets the interval between occurrences in units of the frequency.
+func (r *RRule) Every(interval int) *RRule {
+	r.interval = interval
+	return r
+}
+
+// OnDays limits the occurrences to the weekdays.
+func (r *RRule) OnDays(days ...time.Weekday) *RRule {
+	r.days = append(r.days, days...)
+	return r
+}
+
+// OnMonthDays limits the occurrences to the days of month, negative days count from the end.
+func (r *RRule) OnMonthDays(days ...int) *RRule {
+	r.monthDays = append(r.monthDays, days...)
+	return r
+}
+
+// InMonths limits the occurrences to the months, from 1 to 12.
+func (r *RRule) InMonths(months ...time.Month) *RRule {
+	for _, month := range months {
+		r.months = append(r.months, int(month))
+	}
+	return r
+}
+
+// AtHours limits the occurrences to the hours of day.
+func (r *RRule) AtHours(hours ...int) *RRule {
+	r.hours = append(r.hours, hours...)
+	return r
+}
+
+// AtMinutes limits the occurrences to the minutes of hour.
+func (r *RRule) AtMinutes(minutes ...int) *RRule {
+	r.minutes = append(r.minutes, minutes...)
+	return r
+}
+
+// Times ends the rule after count occurrences.
+func (r *RRule) Times(count int) *RRule {
+	r.count = count
+	return r
+}
+
+// Until ends the rule at the time, inclusive.
+func (r *RRule) Until(until time.Time) *RRule {
+	r.until = until
+	return r
+}
+
+// Exclude removes the occurrences of the rule from this one, sent as EXRULE.
+// The start of the excluded rule is ignored.
+func (r *RRule) Exclude(rule *RRule) *RRule {
+	r.exclusions = append(r.exclusions, rule)
+	return r
+}
+
+// Start returns the time of the first occurrence.
+func (r *RRule) Start() time.Time {
+	return r.start
+}
+
+// Validate checks the rule before it is sent to awx.
+func (r *RRule) Validate() error {
+	if r.start.IsZero() {
+		return errors.New("rrule: start is not set")
+	}
+	if location := r.start.Location(); location != time.UTC {
+		name := location.String()
+		if name == "Local" {
+			return errors.New("rrule: start must be in a named location or UTC, not Local")
+		}
+		// Fixed zones are named by their abbreviation, which is not a tz database name.
+		if _, err := time.LoadLocation(name); err != nil || name == "" {
+			return fmt.Errorf("rrule: start location %q is not a tz database name", name)
+		}
+	}
+
+	if err := r.validateRecurrence(); err != nil {
+		return err
+	}
+
+	for _, exclusion := range r.exclusions {
+		if err := exclusion.validateRecurrence(); err != nil {
+			return fmt.Errorf("exclusion: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *RRule) validateRecurrence() error {
+	switch r.freq {
+	case FrequencyMinutely, FrequencyHourly, FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+	default:
+		return fmt.Errorf("rrule: unknown frequency %q", r.freq)
+	}
+
+	if r.interval < 0 {
+		return errors.New("rrule: interval must not be negative")
+	}
+	if r.count < 0 {
+		return errors.New("rrule: count must not be negative")
+	}
+	if r.count > 0 && !r.until.IsZero() {
+		return errors.New("rrule: count and until are mutually exclusive")
+	}
+	if !r.until.IsZero() && !r.start.IsZero() && r.until.Before(r.start) {
+		return errors.New("rrule: until is before start")
+	}
+
+	for _, day := range r.monthDays {
+		if day == 0 || day < -31 || day > 31 {
+			return fmt.Errorf("rrule: invalid day of month %d", day)
+		}
+	}
+	for _, month := range r.months {
+		if month < 1 || month > 12 {
+			return fmt.Errorf("rrule: invalid month %d", month)
+		}
+	}
+	for _, hour := range r.hours {
+		if hour < 0 || hour > 23 {
+			return fmt.Errorf("rrule: invalid hour %d", hour)
+		}
+	}
+	for _, minute := range r.minutes {
+		if minute < 0 || minute > 59 {
+			return fmt.Errorf("rrule: invalid minute %d", minute)
+		}
+	}
+
+	return nil
+}
+
+// String renders the rule as awx expects it in the `rrule` field of a schedule.
+//
+//	DTSTART;TZID=Europe/Paris:20240101T090000 RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=10
+func (r *RRule) String() string {
+	parts := []string{r.dtstart(), "RRULE:" + r.recurrence()}
+	for _, exclusion := range r.exclusions {
+		parts = append(parts, "EXRULE:"+exclusion.recurrence())
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func (r *RRule) dtstart() string {
+	if r.start.Location() == time.UTC {
+		return "DTSTART:" + r.start.Format(rruleUTCDateLayout)
+	}
+
+	return fmt.Sprintf("DTSTART;TZID=%s:%s", r.start.Location(), r.start.Format(rruleDateLayout))
+}
+
+func (r *RRule) recurrence() string {
+	interval := r.interval
+	if interval == 0 {
+		interval = 1
+	}
+
+	parts := []string{
+		"FREQ=" + r.freq,
+		"INTERVAL=" + strconv.Itoa(interval),
+	}
+
+	if len(r.days) > 0 {
+		days := append([]time.Weekday(nil), r.days...)
+		sort.Slice(days, func(i, j int) bool {
+			// Weeks start on monday in rrules.
+			return (days[i]+6)%7 < (days[j]+6)%7
+		})
+
+		names := make([]string, 0, len(days))
+		for _, day := range days {
+			names = append(names, rruleWeekdays[day])
+		}
+		parts = append(parts, "BYDAY="+strings.Join(names, ","))
+	}
+	if len(r.monthDays) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.monthDays))
+	}
+	if len(r.months) > 0 {
+		parts = append(parts, "BYMONTH="+joinInts(r.months))
+	}
+	if len(r.hours) > 0 {
+		parts = append(parts, "BYHOUR="+joinInts(r.hours))
+	}
+	if len(r.minutes) > 0 {
+		parts = append(parts, "BYMINUTE="+joinInts(r.minutes))
+	}
+	if r.count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.count))
+	}
+	if !r.until.IsZero() {
+		parts = append(parts, "UNTIL="+r.until.UTC().Format(rruleUTCDateLayout))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// mismatch reports why the occurrence can not be produced by the rule, empty when it can.
+// Only the constraints that do not need a full rrule expansion are checked.
+func (r *RRule) mismatch(occurrence time.Time) string {
+	local := occurrence.In(r.start.Location())
+
+	if !r.start.IsZero() && local.Before(r.start) {
+		return "before start"
+	}
+	if !r.until.IsZero() && local.After(r.until) {
+		return "after until"
+	}
+	if len(r.days) > 0 && !containsWeekday(r.days, local.Weekday()) {
+		return "not on " + local.Weekday().String()
+	}
+	if len(r.months) > 0 && !containsInt(r.months, int(local.Month())) {
+		return "not in " + local.Month().String()
+	}
+	if len(r.hours) > 0 && !containsInt(r.hours, local.Hour()) {
+		return "not at hour " + strconv.Itoa(local.Hour())
+	}
+	if len(r.minutes) > 0 && !containsInt(r.minutes, local.Minute()) {
+		return "not at minute " + strconv.Itoa(local.Minute())
+	}
+
+	return ""
+}
+
+func joinInts(values []int) string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, strconv.Itoa(value))
+	}
+
+	return strings.Join(result, ",")
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsWeekday(values []time.Weekday, value time.Weekday) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,201 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Enum of the resources schedules are attached to, used as path of their endpoints.
+const (
+	ScheduleParentJobTemplate         = "job_templates"
+	ScheduleParentWorkflowJobTemplate = "workflow_job_templates"
+	ScheduleParentProject             = "projects"
+	ScheduleParentInventorySource     = "inventory_sources"
+)
+
+// ScheduleService implements awx schedule apis.
+type ScheduleService struct {
+	Requester *Requester
+}
+
+// ListSchedules shows a list of schedules.
+func (s *ScheduleService) ListSchedules(ctx context.Context, params ListParams) (*ListSchedules, error) {
+	result := ListSchedules{}
+	endpoint := "/api/v2/schedules/"
+
+	_, err := s.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SchedulesIter returns an iterator over all schedules, following pagination.
+func (s *ScheduleService) SchedulesIter(params ListParams, opts *ListOptions) *Iterator[*Schedule] {
+	return newIterator[*Schedule](s.Requester, "/api/v2/schedules/", params, opts)
+}
+
+// ListAllSchedules shows a list of schedules from every page.
+func (s *ScheduleService) ListAllSchedules(ctx context.Context, params ListParams, opts *ListOptions) ([]*Schedule, error) {
+	return s.SchedulesIter(params, opts).All(ctx)
+}
+
+// ListSchedulesByParent shows a list of schedules of a job template, workflow job template,
+// project or inventory source, parent is one of ScheduleParent constants.
+func (s *ScheduleService) ListSchedulesByParent(ctx context.Context, parent string, id int, params ListParams) (*ListSchedules, error) {
+	result := ListSchedules{}
+	endpoint := fmt.Sprintf("/api/v2/%s/%d/schedules/", parent, id)
+
+	_, err := s.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetSchedule retrives the schedule information from its ID.
+func (s *ScheduleService) GetSchedule(ctx context.Context, id int) (*Schedule, error) {
+	result := Schedule{}
+	endpoint := fmt.Sprintf("/api/v2/schedules/%d/", id)
+
+	_, err := s.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateSchedule creates a schedule.
+//
+//	name TEXT *REQUIRED
+//	rrule TEXT *REQUIRED
+//	unified_job_template ID *REQUIRED
+//	description TEXT
+//	extra_data JSON
+//	inventory ID
+//	scm_branch TEXT
+//	job_type {run,check}
+//	job_tags TEXT
+//	skip_tags TEXT
+//	limit TEXT
+//	diff_mode BOOLEAN
+//	verbosity {0,1,2,3,4,5}
+//	enabled BOOLEAN
+func (s *ScheduleService) CreateSchedule(ctx context.Context, data map[string]interface{}) (*Schedule, error) {
+	result := Schedule{}
+	endpoint := "/api/v2/schedules/"
+
+	validate, status := ValidateParams(data, []string{"name", "rrule", "unified_job_template"})
+	if !status {
+		return nil, fmt.Errorf("mandatory input arguments are absent: %s", validate)
+	}
+
+	_, err := s.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateScheduleForParent creates a schedule of a job template, workflow job template,
+// project or inventory source, parent is one of ScheduleParent constants.
+// Fields are the same as of CreateSchedule, except unified_job_template.
+func (s *ScheduleService) CreateScheduleForParent(ctx context.Context, parent string, id int, data map[string]interface{}) (*Schedule, error) {
+	result := Schedule{}
+	endpoint := fmt.Sprintf("/api/v2/%s/%d/schedules/", parent, id)
+
+	validate, status := ValidateParams(data, []string{"name", "rrule"})
+	if !status {
+		return nil, fmt.Errorf("mandatory input arguments are absent: %s", validate)
+	}
+
+	_, err := s.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateSchedule update a schedule.
+func (s *ScheduleService) UpdateSchedule(ctx context.Context, id int, data map[string]interface{}) (*Schedule, error) {
+	result := Schedule{}
+	endpoint := fmt.Sprintf("/api/v2/schedules/%d", id)
+
+	_, err := s.Requester.Patch(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteSchedule delete a schedule.
+func (s *ScheduleService) DeleteSchedule(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/schedules/%d", id)
+
+	_, err := s.Requester.Delete(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PreviewSchedule asks awx for the upcoming occurrences of the rrule.
+// AWX returns at most 10 of them.
+func (s *ScheduleService) PreviewSchedule(ctx context.Context, rrule string) (*SchedulePreview, error) {
+	result := SchedulePreview{}
+	endpoint := "/api/v2/schedules/preview/"
+
+	payload := map[string]interface{}{
+		"rrule": rrule,
+	}
+
+	_, err := s.Requester.Post(ctx, endpoint, payload, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// NextOccurrences validates the rule, previews it with awx and returns up to n next occurrences
+// in the location of the rule start. Every occurrence is checked against the rule,
+// so a rule awx understood differently is reported as an error.
+func (s *ScheduleService) NextOccurrences(ctx context.Context, rule *RRule, n int) ([]time.Time, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	preview, err := s.PreviewSchedule(ctx, rule.String())
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := preview.UTC
+	if len(occurrences) == 0 {
+		occurrences = preview.Local
+	}
+	if rule.count > 0 && len(occurrences) > rule.count {
+		return nil, fmt.Errorf("rrule: awx previewed %d occurrences of %d", len(occurrences), rule.count)
+	}
+	if n >= 0 && len(occurrences) > n {
+		occurrences = occurrences[:n]
+	}
+
+	result := make([]time.Time, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		if reason := rule.mismatch(occurrence); reason != "" {
+			return nil, fmt.Errorf("rrule: awx previewed %s which is %s", occurrence.Format(time.RFC3339), reason)
+		}
+		result = append(result, occurrence.In(rule.start.Location()))
+	}
+
+	return result, nil
+}
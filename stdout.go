@@ -0,0 +1,168 @@
+package awx
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Enum of stdout formats.
+const (
+	StdoutFormatTxt  = "txt"
+	StdoutFormatAnsi = "ansi"
+	StdoutFormatJSON = "json"
+	StdoutFormatHTML = "html"
+)
+
+// StdoutOptions selects the lines of the output, counted from 0.
+type StdoutOptions struct {
+	StartLine int
+	// EndLine is exclusive, zero means till the end.
+	EndLine int
+	// ContentFormat is the format of Stdout.Content with json format,
+	// one of txt, ansi or html. AWX renders html when it is empty.
+	ContentFormat string
+}
+
+// StdoutRange represents the lines returned by the stdout endpoint.
+type StdoutRange struct {
+	Start       int `json:"start"`
+	End         int `json:"end"`
+	AbsoluteEnd int `json:"absolute_end"`
+}
+
+// Stdout represents the awx api stdout in json format.
+type Stdout struct {
+	Range   StdoutRange `json:"range"`
+	Content string      `json:"content"`
+}
+
+// FollowOptions configures the stdout following.
+type FollowOptions struct {
+	// Interval is the delay between polls, 1 second by default.
+	Interval time.Duration
+	// Ansi keeps the terminal colors in the output.
+	Ansi bool
+}
+
+// idlePollsAfterFinish is how many empty polls are done after the job finished,
+// older AWX versions may never report that all events are processed.
+const idlePollsAfterFinish = 3
+
+var ansiEscape = regexp.MustCompile("\x1b\\[[0-9;]*[A-Za-z]")
+
+func stdoutQuery(format string, opts *StdoutOptions) map[string]string {
+	query := map[string]string{
+		"format": format,
+	}
+
+	if opts == nil {
+		return query
+	}
+
+	if opts.StartLine > 0 {
+		query["start_line"] = strconv.Itoa(opts.StartLine)
+	}
+	if opts.EndLine > 0 {
+		query["end_line"] = strconv.Itoa(opts.EndLine)
+	}
+	if opts.ContentFormat != "" && format == StdoutFormatJSON {
+		query["content_format"] = opts.ContentFormat
+	}
+
+	return query
+}
+
+// getStdout reads the raw output of any unified job stdout endpoint.
+func getStdout(ctx context.Context, requester *Requester, endpoint string, format string, opts *StdoutOptions) ([]byte, error) {
+	var result []byte
+
+	_, err := requester.Get(ctx, endpoint, &result, stdoutQuery(format, opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// getStdoutJSON reads the output of any unified job stdout endpoint with its line range.
+func getStdoutJSON(ctx context.Context, requester *Requester, endpoint string, opts *StdoutOptions) (*Stdout, error) {
+	result := Stdout{}
+
+	_, err := requester.Get(ctx, endpoint, &result, stdoutQuery(StdoutFormatJSON, opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// followStdout copies new lines of the output into w until the job finishes.
+// state reports the job status and whether all its events are processed,
+// the output is complete only after both.
+func followStdout(ctx context.Context, requester *Requester, endpoint string, w io.Writer, opts *FollowOptions,
+	state func(ctx context.Context) (string, bool, error)) error {
+	if opts == nil {
+		opts = &FollowOptions{}
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	line := 0
+	idle := 0
+	for {
+		status, eventsFinished, err := state(ctx)
+		if err != nil {
+			return err
+		}
+
+		chunk, err := getStdoutJSON(ctx, requester, endpoint, &StdoutOptions{
+			StartLine:     line,
+			ContentFormat: StdoutFormatAnsi,
+		})
+		if err != nil {
+			return err
+		}
+
+		content := chunk.Content
+		if !opts.Ansi {
+			content = ansiEscape.ReplaceAllString(content, "")
+		}
+
+		if content != "" {
+			if _, err := io.WriteString(w, content); err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case chunk.Range.End > line:
+			line = chunk.Range.End
+		case chunk.Content != "":
+			line += strings.Count(chunk.Content, "\n")
+		}
+
+		if IsFinishedStatus(status) {
+			if eventsFinished && chunk.Content == "" {
+				return nil
+			}
+
+			if chunk.Content == "" {
+				idle++
+				if idle >= idlePollsAfterFinish {
+					return nil
+				}
+			}
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
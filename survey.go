@@ -0,0 +1,335 @@
+package awx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Enum of survey question types.
+const (
+	SurveyTypeText           = "text"
+	SurveyTypeTextarea       = "textarea"
+	SurveyTypePassword       = "password"
+	SurveyTypeInteger        = "integer"
+	SurveyTypeFloat          = "float"
+	SurveyTypeMultipleChoice = "multiplechoice"
+	SurveyTypeMultiSelect    = "multiselect"
+)
+
+// SurveySpec represents the awx api survey spec of a job or workflow job template.
+type SurveySpec struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Spec        []*SurveyQuestion `json:"spec"`
+}
+
+// SurveyQuestion represents a single question of the survey spec.
+// Min and Max limit the length of text answers and the value of numeric ones.
+type SurveyQuestion struct {
+	QuestionName        string        `json:"question_name"`
+	QuestionDescription string        `json:"question_description"`
+	Variable            string        `json:"variable"`
+	Type                string        `json:"type"`
+	Required            bool          `json:"required"`
+	Min                 SurveyLimit   `json:"min"`
+	Max                 SurveyLimit   `json:"max"`
+	Default             interface{}   `json:"default,omitempty"`
+	Choices             SurveyChoices `json:"choices,omitempty"`
+	NewQuestion         bool          `json:"new_question,omitempty"`
+}
+
+// SurveyChoices represents the choices of a multiplechoice or multiselect question.
+// Older AWX versions store them as a newline separated string, newer ones as a list,
+// both forms are accepted on decoding.
+type SurveyChoices []string
+
+// UnmarshalJSON decodes the choices from either a string or a list.
+func (c *SurveyChoices) UnmarshalJSON(data []byte) error {
+	var list []string
+	if err := json.Unmarshal(data, &list); err == nil {
+		*c = list
+		return nil
+	}
+
+	var joined string
+	if err := json.Unmarshal(data, &joined); err != nil {
+		return err
+	}
+
+	*c = nil
+	for _, choice := range strings.Split(joined, "\n") {
+		if choice != "" {
+			*c = append(*c, choice)
+		}
+	}
+
+	return nil
+}
+
+// SurveyLimit is the min or max of a survey question, it limits nothing unless Valid.
+// AWX stores unset limits as "" or null, both decode to an invalid limit.
+type SurveyLimit struct {
+	Value float64
+	Valid bool
+}
+
+// UnmarshalJSON decodes the limit from a number, a numeric string, "" or null.
+func (l *SurveyLimit) UnmarshalJSON(data []byte) error {
+	*l = SurveyLimit{}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+
+	var text string
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case json.Number:
+		text = v.String()
+	case string:
+		text = strings.TrimSpace(v)
+		if text == "" {
+			return nil
+		}
+	default:
+		return fmt.Errorf("survey limit must be a number, got %s", data)
+	}
+
+	number, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return fmt.Errorf("survey limit must be a number, got %s", data)
+	}
+	*l = SurveyLimit{Value: number, Valid: true}
+
+	return nil
+}
+
+// MarshalJSON renders an invalid limit as null.
+func (l SurveyLimit) MarshalJSON() ([]byte, error) {
+	if !l.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(l.Value)
+}
+
+// Question returns the question asking for the variable.
+func (s *SurveySpec) Question(variable string) (*SurveyQuestion, bool) {
+	for _, question := range s.Spec {
+		if question.Variable == variable {
+			return question, true
+		}
+	}
+
+	return nil, false
+}
+
+// surveyAnswerRequired is the problem reported for a missing required answer.
+const surveyAnswerRequired = "is required"
+
+// SurveyValidationError maps survey variables to the problems with their values.
+type SurveyValidationError struct {
+	Errors map[string]string
+}
+
+func (e *SurveyValidationError) Error() string {
+	variables := make([]string, 0, len(e.Errors))
+	for variable := range e.Errors {
+		variables = append(variables, variable)
+	}
+	sort.Strings(variables)
+
+	parts := make([]string, 0, len(variables))
+	for _, variable := range variables {
+		parts = append(parts, fmt.Sprintf("%s: %s", variable, e.Errors[variable]))
+	}
+
+	return "invalid survey answers: " + strings.Join(parts, "; ")
+}
+
+// Validate checks the extra vars of a launch against the survey the way awx does:
+// required answers, types, min and max, choices and multiselect lists.
+// Missing required answers with a default are accepted, awx fills the default in.
+// Variables not asked by the survey are not checked.
+// It returns a *SurveyValidationError describing every problem found.
+func (s *SurveySpec) Validate(extraVars map[string]interface{}) error {
+	verr := &SurveyValidationError{
+		Errors: map[string]string{},
+	}
+
+	for _, question := range s.Spec {
+		value, ok := extraVars[question.Variable]
+		if !ok || value == nil {
+			if question.Required && !question.hasDefault() {
+				verr.Errors[question.Variable] = surveyAnswerRequired
+			}
+			continue
+		}
+
+		if problem := question.check(value); problem != "" {
+			verr.Errors[question.Variable] = problem
+		}
+	}
+
+	if len(verr.Errors) == 0 {
+		return nil
+	}
+
+	return verr
+}
+
+func (q *SurveyQuestion) hasDefault() bool {
+	switch value := q.Default.(type) {
+	case nil:
+		return false
+	case string:
+		return value != ""
+	case []interface{}:
+		return len(value) > 0
+	}
+
+	return true
+}
+
+// check returns the problem with the answer of the question, or an empty string.
+func (q *SurveyQuestion) check(value interface{}) string {
+	switch q.Type {
+	case SurveyTypeText, SurveyTypeTextarea, SurveyTypePassword:
+		text, ok := value.(string)
+		if !ok {
+			return "must be a string"
+		}
+		// awx sends back stored passwords as is, their length is unknown.
+		if q.Type == SurveyTypePassword && text == "$encrypted$" {
+			return ""
+		}
+		if text == "" && q.Required {
+			return surveyAnswerRequired
+		}
+
+		length := float64(utf8.RuneCountInString(text))
+		if q.Min.Valid && length < q.Min.Value {
+			return fmt.Sprintf("must be at least %v characters", q.Min.Value)
+		}
+		if q.Max.Valid && length > q.Max.Value {
+			return fmt.Sprintf("must be at most %v characters", q.Max.Value)
+		}
+	case SurveyTypeInteger, SurveyTypeFloat:
+		number, ok := surveyNumber(value)
+		if q.Type == SurveyTypeInteger && (!ok || number != math.Trunc(number)) {
+			return "must be an integer"
+		}
+		if !ok {
+			return "must be a number"
+		}
+
+		if q.Min.Valid && number < q.Min.Value {
+			return fmt.Sprintf("must be at least %v", q.Min.Value)
+		}
+		if q.Max.Valid && number > q.Max.Value {
+			return fmt.Sprintf("must be at most %v", q.Max.Value)
+		}
+	case SurveyTypeMultipleChoice:
+		choice, ok := value.(string)
+		if !ok {
+			return "must be a string"
+		}
+		if choice == "" && q.Required {
+			return surveyAnswerRequired
+		}
+		if choice != "" && !q.Choices.contains(choice) {
+			return fmt.Sprintf("must be one of %s", strings.Join(q.Choices, ", "))
+		}
+	case SurveyTypeMultiSelect:
+		choices, ok := surveyList(value)
+		if !ok {
+			return "must be a list of strings"
+		}
+		if len(choices) == 0 && q.Required {
+			return surveyAnswerRequired
+		}
+		for _, choice := range choices {
+			if !q.Choices.contains(choice) {
+				return fmt.Sprintf("%q is not one of %s", choice, strings.Join(q.Choices, ", "))
+			}
+		}
+	}
+
+	return ""
+}
+
+func (c SurveyChoices) contains(choice string) bool {
+	for _, item := range c {
+		if item == choice {
+			return true
+		}
+	}
+
+	return false
+}
+
+// surveyNumber converts a numeric answer, strings are not accepted just like in awx.
+func surveyNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		number, err := v.Float64()
+		return number, err == nil
+	}
+
+	return 0, false
+}
+
+// surveyList converts a multiselect answer given as []string or a decoded json list.
+func surveyList(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case []string:
+		return v, true
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			choice, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			result = append(result, choice)
+		}
+
+		return result, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,363 @@
+package awx
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSurveySpecValidate(t *testing.T) {
+	min, max := SurveyLimit{Value: 2, Valid: true}, SurveyLimit{Value: 5, Valid: true}
+	spec := &SurveySpec{
+		Spec: []*SurveyQuestion{
+			{Variable: "name", Type: SurveyTypeText, Required: true, Min: min, Max: max},
+			{Variable: "notes", Type: SurveyTypeTextarea},
+			{Variable: "secret", Type: SurveyTypePassword, Required: true, Min: max},
+			{Variable: "count", Type: SurveyTypeInteger, Min: min, Max: max},
+			{Variable: "ratio", Type: SurveyTypeFloat, Max: max},
+			{Variable: "env", Type: SurveyTypeMultipleChoice, Required: true, Default: "dev", Choices: SurveyChoices{"dev", "prod"}},
+			{Variable: "tags", Type: SurveyTypeMultiSelect, Choices: SurveyChoices{"a", "b"}},
+		},
+	}
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{"name": "web", "secret": "hunter22"}
+	}
+
+	tests := []struct {
+		name  string
+		set   map[string]interface{}
+		unset []string
+		want  map[string]string
+	}{
+		{name: "valid answers"},
+		{
+			name:  "required without default",
+			unset: []string{"name", "secret"},
+			want:  map[string]string{"name": "is required", "secret": "is required"},
+		},
+		{
+			name: "nil answer is missing",
+			set:  map[string]interface{}{"name": nil},
+			want: map[string]string{"name": "is required"},
+		},
+		{
+			name: "required with default",
+			set:  map[string]interface{}{"env": nil},
+		},
+		{
+			name: "empty required text",
+			set:  map[string]interface{}{"name": ""},
+			want: map[string]string{"name": "is required"},
+		},
+		{
+			name: "text length counts runes",
+			set:  map[string]interface{}{"name": "żółw", "notes": ""},
+		},
+		{
+			name: "text too short",
+			set:  map[string]interface{}{"name": "w"},
+			want: map[string]string{"name": "must be at least 2 characters"},
+		},
+		{
+			name: "text too long",
+			set:  map[string]interface{}{"name": "webserver"},
+			want: map[string]string{"name": "must be at most 5 characters"},
+		},
+		{
+			name: "text of wrong type",
+			set:  map[string]interface{}{"notes": 1},
+			want: map[string]string{"notes": "must be a string"},
+		},
+		{
+			name: "stored password",
+			set:  map[string]interface{}{"secret": "$encrypted$"},
+		},
+		{
+			name: "short password",
+			set:  map[string]interface{}{"secret": "abc"},
+			want: map[string]string{"secret": "must be at least 5 characters"},
+		},
+		{
+			name: "integer types",
+			set:  map[string]interface{}{"count": int64(3), "ratio": json.Number("1.5")},
+		},
+		{
+			name: "integral float is an integer",
+			set:  map[string]interface{}{"count": 4.0, "ratio": float32(2)},
+		},
+		{
+			name: "fraction is not an integer",
+			set:  map[string]interface{}{"count": 2.5},
+			want: map[string]string{"count": "must be an integer"},
+		},
+		{
+			name: "numbers as strings",
+			set:  map[string]interface{}{"count": "3", "ratio": "1.5"},
+			want: map[string]string{"count": "must be an integer", "ratio": "must be a number"},
+		},
+		{
+			name: "numbers out of range",
+			set:  map[string]interface{}{"count": 1, "ratio": 5.5},
+			want: map[string]string{"count": "must be at least 2", "ratio": "must be at most 5"},
+		},
+		{
+			name: "unknown choice",
+			set:  map[string]interface{}{"env": "stage"},
+			want: map[string]string{"env": "must be one of dev, prod"},
+		},
+		{
+			name: "empty required choice",
+			set:  map[string]interface{}{"env": ""},
+			want: map[string]string{"env": "is required"},
+		},
+		{
+			name: "multiselect of strings",
+			set:  map[string]interface{}{"tags": []string{"a", "b"}},
+		},
+		{
+			name: "multiselect decoded from json",
+			set:  map[string]interface{}{"tags": []interface{}{"b"}},
+		},
+		{
+			name: "empty optional multiselect",
+			set:  map[string]interface{}{"tags": []string{}},
+		},
+		{
+			name: "multiselect unknown choice",
+			set:  map[string]interface{}{"tags": []interface{}{"a", "c"}},
+			want: map[string]string{"tags": `"c" is not one of a, b`},
+		},
+		{
+			name: "multiselect of wrong type",
+			set:  map[string]interface{}{"tags": "a"},
+			want: map[string]string{"tags": "must be a list of strings"},
+		},
+		{
+			name: "multiselect with non string item",
+			set:  map[string]interface{}{"tags": []interface{}{"a", 1}},
+			want: map[string]string{"tags": "must be a list of strings"},
+		},
+		{
+			name: "variables not asked are ignored",
+			set:  map[string]interface{}{"other": []int{1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extraVars := valid()
+			for variable, value := range tt.set {
+				extraVars[variable] = value
+			}
+			for _, variable := range tt.unset {
+				delete(extraVars, variable)
+			}
+
+			err := spec.Validate(extraVars)
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+
+			var verr *SurveyValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("Validate() = %v, want *SurveyValidationError", err)
+			}
+			if !reflect.DeepEqual(verr.Errors, tt.want) {
+				t.Errorf("Validate() errors = %v, want %v", verr.Errors, tt.want)
+			}
+		})
+	}
+}
+
+func TestSurveyRequiredMultiSelect(t *testing.T) {
+	spec := &SurveySpec{
+		Spec: []*SurveyQuestion{
+			{Variable: "tags", Type: SurveyTypeMultiSelect, Required: true, Default: []interface{}{}, Choices: SurveyChoices{"a"}},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		extraVars map[string]interface{}
+	}{
+		{name: "missing with empty default", extraVars: map[string]interface{}{}},
+		{name: "empty list", extraVars: map[string]interface{}{"tags": []string{}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var verr *SurveyValidationError
+			if err := spec.Validate(tt.extraVars); !errors.As(err, &verr) || verr.Errors["tags"] != surveyAnswerRequired {
+				t.Errorf("Validate() = %v, want tags: %s", err, surveyAnswerRequired)
+			}
+		})
+	}
+}
+
+func TestSurveyChoicesUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    SurveyChoices
+		wantErr bool
+	}{
+		{name: "list", data: `["a","b"]`, want: SurveyChoices{"a", "b"}},
+		{name: "newline separated", data: `"a\nb\n"`, want: SurveyChoices{"a", "b"}},
+		{name: "empty string", data: `""`, want: nil},
+		{name: "number", data: `1`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got SurveyChoices
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Unmarshal() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSurveyLimitUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    SurveyLimit
+		wantErr bool
+	}{
+		{name: "integer", data: `3`, want: SurveyLimit{Value: 3, Valid: true}},
+		{name: "float", data: `0.5`, want: SurveyLimit{Value: 0.5, Valid: true}},
+		{name: "numeric string", data: `"10"`, want: SurveyLimit{Value: 10, Valid: true}},
+		{name: "empty string", data: `""`},
+		{name: "null", data: `null`},
+		{name: "text", data: `"ten"`, wantErr: true},
+		{name: "list", data: `[1]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SurveyLimit{Value: 7, Valid: true}
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Unmarshal() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSurveySpecDecodesUnsetLimits(t *testing.T) {
+	data := `{"name": "", "description": "", "spec": [
+		{"variable": "name", "type": "text", "required": true, "min": "", "max": null, "choices": ""},
+		{"variable": "count", "type": "integer", "min": 1, "max": 3, "default": ""}
+	]}`
+
+	var spec SurveySpec
+	if err := json.Unmarshal([]byte(data), &spec); err != nil {
+		t.Fatalf("Unmarshal() = %v", err)
+	}
+
+	var verr *SurveyValidationError
+	err := spec.Validate(map[string]interface{}{"name": "a much longer name than usual", "count": 4})
+	if !errors.As(err, &verr) || !reflect.DeepEqual(verr.Errors, map[string]string{"count": "must be at most 3"}) {
+		t.Errorf("Validate() = %v, want only count: must be at most 3", err)
+	}
+
+	encoded, err := json.Marshal(spec.Spec[0])
+	if err != nil {
+		t.Fatalf("Marshal() = %v", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("Unmarshal() = %v", err)
+	}
+	if decoded["min"] != nil || decoded["max"] != nil {
+		t.Errorf("Marshal() = %s, want null limits", encoded)
+	}
+}
+
+func TestLaunchRequestValidateWithSurvey(t *testing.T) {
+	min := SurveyLimit{Value: 1, Valid: true}
+	spec := &SurveySpec{
+		Spec: []*SurveyQuestion{
+			{Variable: "version", Type: SurveyTypeText, Required: true},
+			{Variable: "region", Type: SurveyTypeMultipleChoice, Required: true, Default: "eu", Choices: SurveyChoices{"eu", "us"}},
+			{Variable: "replicas", Type: SurveyTypeInteger, Min: min},
+		},
+	}
+	info := &LaunchInfo{
+		SurveyEnabled: true,
+		// awx lists every required survey variable, even those with a default.
+		VariablesNeededToStart: []string{"version", "region"},
+		InventoryNeededToStart: true,
+		AskInventoryOnLaunch:   true,
+	}
+
+	tests := []struct {
+		name        string
+		request     *LaunchRequest
+		spec        *SurveySpec
+		wantMissing []string
+		wantInvalid map[string]string
+	}{
+		{
+			name:    "valid",
+			request: &LaunchRequest{Inventory: 1, ExtraVars: map[string]interface{}{"version": "1.2"}},
+			spec:    spec,
+		},
+		{
+			name:        "missing answers",
+			request:     &LaunchRequest{ExtraVars: map[string]interface{}{}},
+			spec:        spec,
+			wantMissing: []string{"inventory", "extra_vars.version"},
+		},
+		{
+			name:        "invalid answers",
+			request:     &LaunchRequest{Inventory: 1, ExtraVars: map[string]interface{}{"version": "1.2", "region": "ap", "replicas": 0}},
+			spec:        spec,
+			wantInvalid: map[string]string{"extra_vars.region": "must be one of eu, us", "extra_vars.replicas": "must be at least 1"},
+		},
+		{
+			name:        "without spec",
+			request:     &LaunchRequest{Inventory: 1, ExtraVars: map[string]interface{}{}},
+			wantMissing: []string{"extra_vars.version", "extra_vars.region"},
+		},
+		{
+			name:    "yaml is left to the server",
+			request: &LaunchRequest{Inventory: 1, ExtraVarsYAML: "version: 1.2\nregion: eu\n"},
+			spec:    spec,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.ValidateWithSurvey(info, tt.spec)
+			if len(tt.wantMissing) == 0 && len(tt.wantInvalid) == 0 {
+				if err != nil {
+					t.Fatalf("ValidateWithSurvey() = %v, want nil", err)
+				}
+				return
+			}
+
+			var verr *LaunchValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("ValidateWithSurvey() = %v, want *LaunchValidationError", err)
+			}
+			if len(verr.Missing) != len(tt.wantMissing) || (len(tt.wantMissing) > 0 && !reflect.DeepEqual(verr.Missing, tt.wantMissing)) {
+				t.Errorf("Missing = %v, want %v", verr.Missing, tt.wantMissing)
+			}
+			if len(verr.Invalid) != len(tt.wantInvalid) || (len(tt.wantInvalid) > 0 && !reflect.DeepEqual(verr.Invalid, tt.wantInvalid)) {
+				t.Errorf("Invalid = %v, want %v", verr.Invalid, tt.wantInvalid)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+)
+
+// TeamService implements awx team apis.
+type TeamService struct {
+	Requester *Requester
+}
+
+// ListTeams shows a list of teams.
+func (t *TeamService) ListTeams(ctx context.Context, params ListParams) (*ListTeams, error) {
+	result := ListTeams{}
+	endpoint := "/api/v2/teams/"
+
+	_, err := t.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// TeamsIter returns an iterator over all teams, following pagination.
+func (t *TeamService) TeamsIter(params ListParams, opts *ListOptions) *Iterator[*Team] {
+	return newIterator[*Team](t.Requester, "/api/v2/teams/", params, opts)
+}
+
+// ListAllTeams shows a list of teams from every page.
+func (t *TeamService) ListAllTeams(ctx context.Context, params ListParams, opts *ListOptions) ([]*Team, error) {
+	return t.TeamsIter(params, opts).All(ctx)
+}
+
+// GetTeam retrives the team information from its ID.
+func (t *TeamService) GetTeam(ctx context.Context, id int) (*Team, error) {
+	result := Team{}
+	endpoint := fmt.Sprintf("/api/v2/teams/%d/", id)
+
+	_, err := t.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateTeam creates a team.
+//
+//	name TEXT *REQUIRED
+//	organization ID *REQUIRED
+//	description TEXT
+func (t *TeamService) CreateTeam(ctx context.Context, data map[string]interface{}) (*Team, error) {
+	result := Team{}
+	endpoint := "/api/v2/teams/"
+
+	validate, status := ValidateParams(data, []string{"name", "organization"})
+	if !status {
+		return nil, fmt.Errorf("mandatory input arguments are absent: %s", validate)
+	}
+
+	_, err := t.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateTeam update a team.
+func (t *TeamService) UpdateTeam(ctx context.Context, id int, data map[string]interface{}) (*Team, error) {
+	result := Team{}
+	endpoint := fmt.Sprintf("/api/v2/teams/%d", id)
+
+	_, err := t.Requester.Patch(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteTeam delete a team.
+func (t *TeamService) DeleteTeam(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/teams/%d", id)
+
+	_, err := t.Requester.Delete(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListTeamUsers shows the members of the team.
+func (t *TeamService) ListTeamUsers(ctx context.Context, id int, params ListParams) (*ListUsers, error) {
+	result := ListUsers{}
+	endpoint := fmt.Sprintf("/api/v2/teams/%d/users/", id)
+
+	_, err := t.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// AddMember adds the user to the team.
+func (t *TeamService) AddMember(ctx context.Context, id int, userID int) error {
+	return associate(ctx, t.Requester, fmt.Sprintf("/api/v2/teams/%d/users/", id), userID, false)
+}
+
+// RemoveMember removes the user from the team.
+func (t *TeamService) RemoveMember(ctx context.Context, id int, userID int) error {
+	return associate(ctx, t.Requester, fmt.Sprintf("/api/v2/teams/%d/users/", id), userID, true)
+}
+
+// ListTeamRoles shows the roles granted to the team.
+func (t *TeamService) ListTeamRoles(ctx context.Context, id int, params ListParams) (*ListRoles, error) {
+	result := ListRoles{}
+	endpoint := fmt.Sprintf("/api/v2/teams/%d/roles/", id)
+
+	_, err := t.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GrantRole grants the role to the team, see RoleService.GetObjectRole to find its ID.
+func (t *TeamService) GrantRole(ctx context.Context, id int, roleID int) error {
+	return associate(ctx, t.Requester, fmt.Sprintf("/api/v2/teams/%d/roles/", id), roleID, false)
+}
+
+// RevokeRole revokes the role from the team.
+func (t *TeamService) RevokeRole(ctx context.Context, id int, roleID int) error {
+	return associate(ctx, t.Requester, fmt.Sprintf("/api/v2/teams/%d/roles/", id), roleID, true)
+}
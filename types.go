@@ -11,9 +11,9 @@ import (
 
 // Pagination represents the awx api pagination params.
 type Pagination struct {
-	Count    int         `json:"count"`
-	Next     interface{} `json:"next"`
-	Previous interface{} `json:"previous"`
+	Count    int     `json:"count"`
+	Next     *string `json:"next"`
+	Previous *string `json:"previous"`
 }
 
 // ProjectUpdateCancel represents the awx project update cancel api response.
@@ -172,23 +172,72 @@ type Summary struct {
 	InventorySource    *InventorySource       `json:"inventory_source"`
 }
 
+// InventorySource represents the awx api inventory source.
+// The summary form embedded into other objects fills only a part of the fields.
 type InventorySource struct {
-	Source      string    `json:"source"`
-	LastUpdated time.Time `json:"last_updated"`
-	Status      string    `json:"status"`
+	ID                   int       `json:"id"`
+	Type                 string    `json:"type"`
+	URL                  string    `json:"url"`
+	Related              *Related  `json:"related"`
+	SummaryFields        *Summary  `json:"summary_fields"`
+	Created              time.Time `json:"created"`
+	Modified             time.Time `json:"modified"`
+	Name                 string    `json:"name"`
+	Description          string    `json:"description"`
+	Source               string    `json:"source"`
+	SourcePath           string    `json:"source_path"`
+	SourceVars           string    `json:"source_vars"`
+	ScmBranch            string    `json:"scm_branch"`
+	Credential           int       `json:"credential"`
+	EnabledVar           string    `json:"enabled_var"`
+	EnabledValue         string    `json:"enabled_value"`
+	HostFilter           string    `json:"host_filter"`
+	Overwrite            bool      `json:"overwrite"`
+	OverwriteVars        bool      `json:"overwrite_vars"`
+	Timeout              int       `json:"timeout"`
+	Verbosity            int       `json:"verbosity"`
+	Limit                string    `json:"limit"`
+	ExecutionEnvironment int       `json:"execution_environment"`
+	LastJobRun           time.Time `json:"last_job_run"`
+	LastJobFailed        bool      `json:"last_job_failed"`
+	NextJobRun           time.Time `json:"next_job_run"`
+	Status               string    `json:"status"`
+	Inventory            int       `json:"inventory"`
+	UpdateOnLaunch       bool      `json:"update_on_launch"`
+	UpdateCacheTimeout   int       `json:"update_cache_timeout"`
+	SourceProject        int       `json:"source_project"`
+	LastUpdateFailed     bool      `json:"last_update_failed"`
+	LastUpdated          time.Time `json:"last_updated"`
+}
+
+// ListInventorySources represents `ListInventorySources` endpoint response.
+type ListInventorySources struct {
+	Pagination
+	Results []*InventorySource `json:"results"`
+}
+
+// InventorySourceUpdate represents the awx api response of an inventory source update start.
+type InventorySourceUpdate struct {
+	InventoryUpdate int    `json:"inventory_update"`
+	ID              int    `json:"id"`
+	Type            string `json:"type"`
+	URL             string `json:"url"`
+	Status          string `json:"status"`
 }
 
 type Organization struct {
 	ID                 int         `json:"id"`
 	Type               string      `json:"type"`
 	URL                string      `json:"url"`
+	Related            *Related    `json:"related"`
+	SummaryFields      *Summary    `json:"summary_fields"`
 	Created            time.Time   `json:"created"`
 	Modified           time.Time   `json:"modified"`
 	Name               string      `json:"name"`
 	Description        string      `json:"description"`
 	MaxHosts           int         `json:"max_hosts"`
 	CustomVirtualenv   interface{} `json:"custom_virtualenv"`
-	DefaultEnvironment interface{} `json:"default_environment"`
+	DefaultEnvironment *int        `json:"default_environment"`
 }
 
 type ListOrganizations struct {
@@ -198,43 +247,94 @@ type ListOrganizations struct {
 
 // ProjectUpdate represents the awx api project update.
 type ProjectUpdate struct {
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Status      string `json:"status"`
-	Failed      bool   `json:"failed"`
+	ID                      int               `json:"id"`
+	Type                    string            `json:"type"`
+	URL                     string            `json:"url"`
+	Related                 *Related          `json:"related"`
+	SummaryFields           *Summary          `json:"summary_fields"`
+	Created                 time.Time         `json:"created"`
+	Modified                time.Time         `json:"modified"`
+	Name                    string            `json:"name"`
+	Description             string            `json:"description"`
+	Status                  string            `json:"status"`
+	Failed                  bool              `json:"failed"`
+	Project                 int               `json:"project"`
+	LocalPath               string            `json:"local_path"`
+	ScmType                 string            `json:"scm_type"`
+	ScmURL                  string            `json:"scm_url"`
+	ScmBranch               string            `json:"scm_branch"`
+	ScmRefspec              string            `json:"scm_refspec"`
+	ScmClean                bool              `json:"scm_clean"`
+	ScmTrackSubmodules      bool              `json:"scm_track_submodules"`
+	ScmDeleteOnUpdate       bool              `json:"scm_delete_on_update"`
+	ScmRevision             string            `json:"scm_revision"`
+	Credential              int               `json:"credential"`
+	Timeout                 int               `json:"timeout"`
+	UnifiedJobTemplate      int               `json:"unified_job_template"`
+	LaunchType              string            `json:"launch_type"`
+	Started                 time.Time         `json:"started"`
+	Finished                time.Time         `json:"finished"`
+	CanceledOn              time.Time         `json:"canceled_on"`
+	Elapsed                 float64           `json:"elapsed"`
+	JobArgs                 string            `json:"job_args"`
+	JobCwd                  string            `json:"job_cwd"`
+	JobEnv                  map[string]string `json:"job_env"`
+	JobExplanation          string            `json:"job_explanation"`
+	ExecutionNode           string            `json:"execution_node"`
+	ResultTraceback         string            `json:"result_traceback"`
+	EventProcessingFinished bool              `json:"event_processing_finished"`
+	JobType                 string            `json:"job_type"`
+	JobTags                 string            `json:"job_tags"`
+	ProjectUpdate           int               `json:"project_update"`
+}
+
+// ListProjectUpdates represents `ListProjectUpdates` endpoint response.
+type ListProjectUpdates struct {
+	Pagination
+	Results []*ProjectUpdate `json:"results"`
 }
 
 // Project represents the awx api project.
 type Project struct {
-	ID                    int       `json:"id"`
-	Type                  string    `json:"type"`
-	URL                   string    `json:"url"`
-	Related               *Related  `json:"related"`
-	SummaryFields         *Summary  `json:"summary_fields"`
-	Created               time.Time `json:"created"`
-	Modified              time.Time `json:"modified"`
-	Name                  string    `json:"name"`
-	Description           string    `json:"description"`
-	LocalPath             string    `json:"local_path"`
-	ScmType               string    `json:"scm_type"`
-	ScmURL                string    `json:"scm_url"`
-	ScmBranch             string    `json:"scm_branch"`
-	ScmClean              bool      `json:"scm_clean"`
-	ScmDeleteOnUpdate     bool      `json:"scm_delete_on_update"`
-	Credential            string    `json:"credential"`
-	Timeout               int       `json:"timeout"`
-	LastJobRun            time.Time `json:"last_job_run"`
-	LastJobFailed         bool      `json:"last_job_failed"`
-	NextJobRun            time.Time `json:"next_job_run"`
-	Status                string    `json:"status"`
-	Organization          int       `json:"organization"`
-	ScmDeleteOnNextUpdate bool      `json:"scm_delete_on_next_update"`
-	ScmUpdateOnLaunch     bool      `json:"scm_update_on_launch"`
-	ScmUpdateCacheTimeout int       `json:"scm_update_cache_timeout"`
-	ScmRevision           string    `json:"scm_revision"`
-	LastUpdateFailed      bool      `json:"last_update_failed"`
-	LastUpdated           time.Time `json:"last_updated"`
+	ID                            int       `json:"id"`
+	Type                          string    `json:"type"`
+	URL                           string    `json:"url"`
+	Related                       *Related  `json:"related"`
+	SummaryFields                 *Summary  `json:"summary_fields"`
+	Created                       time.Time `json:"created"`
+	Modified                      time.Time `json:"modified"`
+	Name                          string    `json:"name"`
+	Description                   string    `json:"description"`
+	LocalPath                     string    `json:"local_path"`
+	ScmType                       string    `json:"scm_type"`
+	ScmURL                        string    `json:"scm_url"`
+	ScmBranch                     string    `json:"scm_branch"`
+	ScmRefspec                    string    `json:"scm_refspec"`
+	ScmClean                      bool      `json:"scm_clean"`
+	ScmTrackSubmodules            bool      `json:"scm_track_submodules"`
+	ScmDeleteOnUpdate             bool      `json:"scm_delete_on_update"`
+	Credential                    int       `json:"credential"`
+	Timeout                       int       `json:"timeout"`
+	LastJobRun                    time.Time `json:"last_job_run"`
+	LastJobFailed                 bool      `json:"last_job_failed"`
+	NextJobRun                    time.Time `json:"next_job_run"`
+	Status                        string    `json:"status"`
+	Organization                  int       `json:"organization"`
+	ScmDeleteOnNextUpdate         bool      `json:"scm_delete_on_next_update"`
+	ScmUpdateOnLaunch             bool      `json:"scm_update_on_launch"`
+	ScmUpdateCacheTimeout         int       `json:"scm_update_cache_timeout"`
+	ScmRevision                   string    `json:"scm_revision"`
+	AllowOverride                 bool      `json:"allow_override"`
+	DefaultEnvironment            int       `json:"default_environment"`
+	SignatureValidationCredential int       `json:"signature_validation_credential"`
+	LastUpdateFailed              bool      `json:"last_update_failed"`
+	LastUpdated                   time.Time `json:"last_updated"`
+}
+
+// ListProjects represents `ListProjects` endpoint response.
+type ListProjects struct {
+	Pagination
+	Results []*Project `json:"results"`
 }
 
 // Inventory represents the awx api inventory.
@@ -319,21 +419,134 @@ type InventoryUpdate struct {
 	InventoryUpdate         int         `json:"inventory_update"`
 }
 
+// ListInventoryUpdates represents `ListInventoryUpdates` endpoint response.
+type ListInventoryUpdates struct {
+	Pagination
+	Results []*InventoryUpdate `json:"results"`
+}
+
 // Credential represents the awx api credential.
+// The summary form embedded into other objects fills only a part of the fields.
 type Credential struct {
-	Description      string `json:"description"`
-	CredentialTypeID int    `json:"credential_type_id"`
-	ID               int    `json:"id"`
-	Kind             string `json:"kind"`
-	Name             string `json:"name"`
+	ID               int              `json:"id"`
+	Type             string           `json:"type"`
+	URL              string           `json:"url"`
+	Related          *Related         `json:"related"`
+	SummaryFields    *Summary         `json:"summary_fields"`
+	Created          time.Time        `json:"created"`
+	Modified         time.Time        `json:"modified"`
+	Name             string           `json:"name"`
+	Description      string           `json:"description"`
+	Organization     int              `json:"organization"`
+	CredentialType   int              `json:"credential_type"`
+	CredentialTypeID int              `json:"credential_type_id"`
+	Managed          bool             `json:"managed"`
+	Inputs           CredentialInputs `json:"inputs"`
+	Kind             string           `json:"kind"`
+	Cloud            bool             `json:"cloud"`
+	Kubernetes       bool             `json:"kubernetes"`
+}
+
+// ListCredentials represents `ListCredentials` endpoint response.
+type ListCredentials struct {
+	Pagination
+	Results []*Credential `json:"results"`
+}
+
+// CredentialTypeField represents a field of the credential type inputs schema.
+type CredentialTypeField struct {
+	ID           string   `json:"id"`
+	Label        string   `json:"label"`
+	Type         string   `json:"type"`
+	HelpText     string   `json:"help_text"`
+	Secret       bool     `json:"secret"`
+	Multiline    bool     `json:"multiline"`
+	Format       string   `json:"format"`
+	Choices      []string `json:"choices"`
+	AskAtRuntime bool     `json:"ask_at_runtime"`
+}
+
+// CredentialTypeInputs represents the inputs schema of the credential type.
+type CredentialTypeInputs struct {
+	Fields   []CredentialTypeField `json:"fields"`
+	Metadata []CredentialTypeField `json:"metadata,omitempty"`
+	Required []string              `json:"required,omitempty"`
+}
+
+// CredentialType represents the awx api credential type.
+type CredentialType struct {
+	ID            int                    `json:"id"`
+	Type          string                 `json:"type"`
+	URL           string                 `json:"url"`
+	Related       *Related               `json:"related"`
+	SummaryFields *Summary               `json:"summary_fields"`
+	Created       time.Time              `json:"created"`
+	Modified      time.Time              `json:"modified"`
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	Kind          string                 `json:"kind"`
+	Namespace     string                 `json:"namespace"`
+	Managed       bool                   `json:"managed"`
+	Inputs        CredentialTypeInputs   `json:"inputs"`
+	Injectors     map[string]interface{} `json:"injectors"`
+}
+
+// ListCredentialTypes represents `ListCredentialTypes` endpoint response.
+type ListCredentialTypes struct {
+	Pagination
+	Results []*CredentialType `json:"results"`
+}
+
+// CredentialInputSource represents the awx api credential input source,
+// it fills an input of the target credential from an external secret backend.
+type CredentialInputSource struct {
+	ID               int                    `json:"id"`
+	Type             string                 `json:"type"`
+	URL              string                 `json:"url"`
+	Related          *Related               `json:"related"`
+	SummaryFields    *Summary               `json:"summary_fields"`
+	Created          time.Time              `json:"created"`
+	Modified         time.Time              `json:"modified"`
+	Description      string                 `json:"description"`
+	InputFieldName   string                 `json:"input_field_name"`
+	Metadata         map[string]interface{} `json:"metadata"`
+	TargetCredential int                    `json:"target_credential"`
+	SourceCredential int                    `json:"source_credential"`
+}
+
+// ListCredentialInputSources represents `ListCredentialInputSources` endpoint response.
+type ListCredentialInputSources struct {
+	Pagination
+	Results []*CredentialInputSource `json:"results"`
 }
 
 // UnifiedJobTemplate represents the awx api unified job template.
+// The summary form embedded into other objects fills only a part of the fields.
+// Timeout is filled for workflow approval templates only.
 type UnifiedJobTemplate struct {
-	ID             int    `json:"id"`
-	Name           string `json:"name"`
-	Description    string `json:"description"`
-	UnifiedJobType string `json:"unified_job_type"`
+	ID             int       `json:"id"`
+	Type           string    `json:"type"`
+	URL            string    `json:"url"`
+	Related        *Related  `json:"related"`
+	SummaryFields  *Summary  `json:"summary_fields"`
+	Created        time.Time `json:"created"`
+	Modified       time.Time `json:"modified"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	UnifiedJobType string    `json:"unified_job_type"`
+	Timeout        int       `json:"timeout"`
+	Status         string    `json:"status"`
+	LastJobFailed  bool      `json:"last_job_failed"`
+	LastJobRun     time.Time `json:"last_job_run"`
+	NextJobRun     time.Time `json:"next_job_run"`
+	// Value is the template decoded by Type, see UnifiedJobTemplate.UnmarshalJSON.
+	Value interface{} `json:"-"`
+}
+
+// ListUnifiedJobTemplates represents `ListUnifiedJobTemplates` endpoint response.
+type ListUnifiedJobTemplates struct {
+	Pagination
+	Results []*UnifiedJobTemplate `json:"results"`
 }
 
 // InstanceGroup represents the awx api instance group.
@@ -374,50 +587,60 @@ type Ping struct {
 
 // JobTemplate represents the awx api job template.
 type JobTemplate struct {
-	ID                    int         `json:"id"`
-	Type                  string      `json:"type"`
-	URL                   string      `json:"url"`
-	Related               *Related    `json:"related"`
-	SummaryFields         *Summary    `json:"summary_fields"`
-	Created               time.Time   `json:"created"`
-	Modified              time.Time   `json:"modified"`
-	Name                  string      `json:"name"`
-	Description           string      `json:"description"`
-	JobType               string      `json:"job_type"`
-	Inventory             int         `json:"inventory"`
-	Project               int         `json:"project"`
-	Playbook              string      `json:"playbook"`
-	Forks                 int         `json:"forks"`
-	Limit                 string      `json:"limit"`
-	Verbosity             int         `json:"verbosity"`
-	ExtraVars             string      `json:"extra_vars"`
-	JobTags               string      `json:"job_tags"`
-	ForceHandlers         bool        `json:"force_handlers"`
-	SkipTags              string      `json:"skip_tags"`
-	StartAtTask           string      `json:"start_at_task"`
-	Timeout               int         `json:"timeout"`
-	UseFactCache          bool        `json:"use_fact_cache"`
-	LastJobRun            interface{} `json:"last_job_run"`
-	LastJobFailed         bool        `json:"last_job_failed"`
-	NextJobRun            interface{} `json:"next_job_run"`
-	Status                string      `json:"status"`
-	HostConfigKey         string      `json:"host_config_key"`
-	AskDiffModeOnLaunch   bool        `json:"ask_diff_mode_on_launch"`
-	AskVariablesOnLaunch  bool        `json:"ask_variables_on_launch"`
-	AskLimitOnLaunch      bool        `json:"ask_limit_on_launch"`
-	AskTagsOnLaunch       bool        `json:"ask_tags_on_launch"`
-	AskSkipTagsOnLaunch   bool        `json:"ask_skip_tags_on_launch"`
-	AskJobTypeOnLaunch    bool        `json:"ask_job_type_on_launch"`
-	AskVerbosityOnLaunch  bool        `json:"ask_verbosity_on_launch"`
-	AskInventoryOnLaunch  bool        `json:"ask_inventory_on_launch"`
-	AskCredentialOnLaunch bool        `json:"ask_credential_on_launch"`
-	SurveyEnabled         bool        `json:"survey_enabled"`
-	BecomeEnabled         bool        `json:"become_enabled"`
-	DiffMode              bool        `json:"diff_mode"`
-	AllowSimultaneous     bool        `json:"allow_simultaneous"`
-	CustomVirtualenv      interface{} `json:"custom_virtualenv"`
-	Credential            int         `json:"credential"`
-	VaultCredential       interface{} `json:"vault_credential"`
+	ID                              int         `json:"id"`
+	Type                            string      `json:"type"`
+	URL                             string      `json:"url"`
+	Related                         *Related    `json:"related"`
+	SummaryFields                   *Summary    `json:"summary_fields"`
+	Created                         time.Time   `json:"created"`
+	Modified                        time.Time   `json:"modified"`
+	Name                            string      `json:"name"`
+	Description                     string      `json:"description"`
+	JobType                         string      `json:"job_type"`
+	Inventory                       int         `json:"inventory"`
+	Project                         int         `json:"project"`
+	Playbook                        string      `json:"playbook"`
+	ScmBranch                       string      `json:"scm_branch"`
+	Forks                           int         `json:"forks"`
+	Limit                           string      `json:"limit"`
+	Verbosity                       int         `json:"verbosity"`
+	ExtraVars                       string      `json:"extra_vars"`
+	JobTags                         string      `json:"job_tags"`
+	ForceHandlers                   bool        `json:"force_handlers"`
+	SkipTags                        string      `json:"skip_tags"`
+	StartAtTask                     string      `json:"start_at_task"`
+	Timeout                         int         `json:"timeout"`
+	UseFactCache                    bool        `json:"use_fact_cache"`
+	ExecutionEnvironment            int         `json:"execution_environment"`
+	JobSliceCount                   int         `json:"job_slice_count"`
+	LastJobRun                      interface{} `json:"last_job_run"`
+	LastJobFailed                   bool        `json:"last_job_failed"`
+	NextJobRun                      interface{} `json:"next_job_run"`
+	Status                          string      `json:"status"`
+	HostConfigKey                   string      `json:"host_config_key"`
+	AskDiffModeOnLaunch             bool        `json:"ask_diff_mode_on_launch"`
+	AskVariablesOnLaunch            bool        `json:"ask_variables_on_launch"`
+	AskLimitOnLaunch                bool        `json:"ask_limit_on_launch"`
+	AskTagsOnLaunch                 bool        `json:"ask_tags_on_launch"`
+	AskSkipTagsOnLaunch             bool        `json:"ask_skip_tags_on_launch"`
+	AskJobTypeOnLaunch              bool        `json:"ask_job_type_on_launch"`
+	AskVerbosityOnLaunch            bool        `json:"ask_verbosity_on_launch"`
+	AskInventoryOnLaunch            bool        `json:"ask_inventory_on_launch"`
+	AskCredentialOnLaunch           bool        `json:"ask_credential_on_launch"`
+	AskScmBranchOnLaunch            bool        `json:"ask_scm_branch_on_launch"`
+	AskExecutionEnvironmentOnLaunch bool        `json:"ask_execution_environment_on_launch"`
+	AskLabelsOnLaunch               bool        `json:"ask_labels_on_launch"`
+	AskForksOnLaunch                bool        `json:"ask_forks_on_launch"`
+	AskJobSliceCountOnLaunch        bool        `json:"ask_job_slice_count_on_launch"`
+	AskTimeoutOnLaunch              bool        `json:"ask_timeout_on_launch"`
+	AskInstanceGroupsOnLaunch       bool        `json:"ask_instance_groups_on_launch"`
+	SurveyEnabled                   bool        `json:"survey_enabled"`
+	BecomeEnabled                   bool        `json:"become_enabled"`
+	DiffMode                        bool        `json:"diff_mode"`
+	AllowSimultaneous               bool        `json:"allow_simultaneous"`
+	CustomVirtualenv                interface{} `json:"custom_virtualenv"`
+	Credential                      int         `json:"credential"`
+	VaultCredential                 interface{} `json:"vault_credential"`
 }
 
 // ListJobTemplates represents `ListJobTemplates` endpoint response.
@@ -546,6 +769,308 @@ type Job struct {
 	VaultCredential         interface{}       `json:"vault_credential"`
 }
 
+// WorkflowJobTemplate represents the awx api workflow job template.
+type WorkflowJobTemplate struct {
+	ID                   int         `json:"id"`
+	Type                 string      `json:"type"`
+	URL                  string      `json:"url"`
+	Related              *Related    `json:"related"`
+	SummaryFields        *Summary    `json:"summary_fields"`
+	Created              time.Time   `json:"created"`
+	Modified             time.Time   `json:"modified"`
+	Name                 string      `json:"name"`
+	Description          string      `json:"description"`
+	LastJobRun           interface{} `json:"last_job_run"`
+	LastJobFailed        bool        `json:"last_job_failed"`
+	NextJobRun           interface{} `json:"next_job_run"`
+	Status               string      `json:"status"`
+	ExtraVars            string      `json:"extra_vars"`
+	Organization         int         `json:"organization"`
+	SurveyEnabled        bool        `json:"survey_enabled"`
+	AllowSimultaneous    bool        `json:"allow_simultaneous"`
+	Inventory            int         `json:"inventory"`
+	Limit                string      `json:"limit"`
+	ScmBranch            string      `json:"scm_branch"`
+	JobTags              string      `json:"job_tags"`
+	SkipTags             string      `json:"skip_tags"`
+	AskVariablesOnLaunch bool        `json:"ask_variables_on_launch"`
+	AskInventoryOnLaunch bool        `json:"ask_inventory_on_launch"`
+	AskScmBranchOnLaunch bool        `json:"ask_scm_branch_on_launch"`
+	AskLimitOnLaunch     bool        `json:"ask_limit_on_launch"`
+	AskLabelsOnLaunch    bool        `json:"ask_labels_on_launch"`
+	AskTagsOnLaunch      bool        `json:"ask_tags_on_launch"`
+	AskSkipTagsOnLaunch  bool        `json:"ask_skip_tags_on_launch"`
+	WebhookService       string      `json:"webhook_service"`
+	WebhookCredential    int         `json:"webhook_credential"`
+}
+
+// ListWorkflowJobTemplates represents `ListWorkflowJobTemplates` endpoint response.
+type ListWorkflowJobTemplates struct {
+	Pagination
+	Results []*WorkflowJobTemplate `json:"results"`
+}
+
+// WorkflowJob represents the awx api workflow job.
+type WorkflowJob struct {
+	ID                  int               `json:"id"`
+	Type                string            `json:"type"`
+	URL                 string            `json:"url"`
+	Related             *Related          `json:"related"`
+	SummaryFields       *Summary          `json:"summary_fields"`
+	Created             time.Time         `json:"created"`
+	Modified            time.Time         `json:"modified"`
+	Name                string            `json:"name"`
+	Description         string            `json:"description"`
+	UnifiedJobTemplate  int               `json:"unified_job_template"`
+	LaunchType          string            `json:"launch_type"`
+	Status              string            `json:"status"`
+	Failed              bool              `json:"failed"`
+	Started             time.Time         `json:"started"`
+	Finished            time.Time         `json:"finished"`
+	CanceledOn          time.Time         `json:"canceled_on"`
+	Elapsed             float64           `json:"elapsed"`
+	JobArgs             string            `json:"job_args"`
+	JobCwd              string            `json:"job_cwd"`
+	JobEnv              map[string]string `json:"job_env"`
+	JobExplanation      string            `json:"job_explanation"`
+	ResultTraceback     string            `json:"result_traceback"`
+	WorkflowJobTemplate int               `json:"workflow_job_template"`
+	ExtraVars           string            `json:"extra_vars"`
+	AllowSimultaneous   bool              `json:"allow_simultaneous"`
+	JobTemplate         int               `json:"job_template"`
+	IsSlicedJob         bool              `json:"is_sliced_job"`
+	Inventory           int               `json:"inventory"`
+	Limit               string            `json:"limit"`
+	ScmBranch           string            `json:"scm_branch"`
+	JobTags             string            `json:"job_tags"`
+	SkipTags            string            `json:"skip_tags"`
+	WebhookService      string            `json:"webhook_service"`
+	WebhookCredential   int               `json:"webhook_credential"`
+	WebhookGUID         string            `json:"webhook_guid"`
+}
+
+// ListWorkflowJobs represents `ListWorkflowJobs` endpoint response.
+type ListWorkflowJobs struct {
+	Pagination
+	Results []*WorkflowJob `json:"results"`
+}
+
+// WorkflowJobLaunch represents the awx api workflow job launch.
+type WorkflowJobLaunch struct {
+	WorkflowJob         int                    `json:"workflow_job"`
+	IgnoredFields       map[string]interface{} `json:"ignored_fields"`
+	ID                  int                    `json:"id"`
+	Type                string                 `json:"type"`
+	URL                 string                 `json:"url"`
+	Related             *Related               `json:"related"`
+	SummaryFields       *Summary               `json:"summary_fields"`
+	Created             time.Time              `json:"created"`
+	Modified            time.Time              `json:"modified"`
+	Name                string                 `json:"name"`
+	Description         string                 `json:"description"`
+	UnifiedJobTemplate  int                    `json:"unified_job_template"`
+	LaunchType          string                 `json:"launch_type"`
+	Status              string                 `json:"status"`
+	Failed              bool                   `json:"failed"`
+	WorkflowJobTemplate int                    `json:"workflow_job_template"`
+	ExtraVars           string                 `json:"extra_vars"`
+	Inventory           int                    `json:"inventory"`
+	Limit               string                 `json:"limit"`
+	ScmBranch           string                 `json:"scm_branch"`
+}
+
+// UnifiedJobSummary represents the awx api summary of any kind of job.
+type UnifiedJobSummary struct {
+	ID             int     `json:"id"`
+	Name           string  `json:"name"`
+	Description    string  `json:"description"`
+	Status         string  `json:"status"`
+	Failed         bool    `json:"failed"`
+	Elapsed        float64 `json:"elapsed"`
+	Type           string  `json:"type"`
+	UnifiedJobType string  `json:"unified_job_type"`
+}
+
+// WorkflowJobNodeSummary represents the awx api workflow job node summary fields.
+type WorkflowJobNodeSummary struct {
+	Job                *UnifiedJobSummary  `json:"job"`
+	WorkflowJob        *UnifiedJobSummary  `json:"workflow_job"`
+	UnifiedJobTemplate *UnifiedJobTemplate `json:"unified_job_template"`
+	Inventory          *Inventory          `json:"inventory"`
+}
+
+// WorkflowJobNode represents the awx api node of a running workflow job.
+type WorkflowJobNode struct {
+	ID                     int                     `json:"id"`
+	Type                   string                  `json:"type"`
+	URL                    string                  `json:"url"`
+	Related                *Related                `json:"related"`
+	SummaryFields          *WorkflowJobNodeSummary `json:"summary_fields"`
+	Created                time.Time               `json:"created"`
+	Modified               time.Time               `json:"modified"`
+	ExtraData              map[string]interface{}  `json:"extra_data"`
+	Inventory              int                     `json:"inventory"`
+	ScmBranch              string                  `json:"scm_branch"`
+	JobType                string                  `json:"job_type"`
+	JobTags                string                  `json:"job_tags"`
+	SkipTags               string                  `json:"skip_tags"`
+	Limit                  string                  `json:"limit"`
+	DiffMode               *bool                   `json:"diff_mode"`
+	Verbosity              *int                    `json:"verbosity"`
+	Job                    int                     `json:"job"`
+	WorkflowJob            int                     `json:"workflow_job"`
+	UnifiedJobTemplate     int                     `json:"unified_job_template"`
+	SuccessNodes           []int                   `json:"success_nodes"`
+	FailureNodes           []int                   `json:"failure_nodes"`
+	AlwaysNodes            []int                   `json:"always_nodes"`
+	AllParentsMustConverge bool                    `json:"all_parents_must_converge"`
+	DoNotRun               bool                    `json:"do_not_run"`
+	Identifier             string                  `json:"identifier"`
+}
+
+// ListWorkflowJobNodes represents `ListWorkflowJobNodes` endpoint response.
+type ListWorkflowJobNodes struct {
+	Pagination
+	Results []*WorkflowJobNode `json:"results"`
+}
+
+// WorkflowJobTemplateNodeSummary represents the awx api workflow job template node summary fields.
+type WorkflowJobTemplateNodeSummary struct {
+	WorkflowJobTemplate *UnifiedJobTemplate `json:"workflow_job_template"`
+	UnifiedJobTemplate  *UnifiedJobTemplate `json:"unified_job_template"`
+	Inventory           *Inventory          `json:"inventory"`
+}
+
+// WorkflowJobTemplateNode represents the awx api node of a workflow job template.
+type WorkflowJobTemplateNode struct {
+	ID                     int                             `json:"id"`
+	Type                   string                          `json:"type"`
+	URL                    string                          `json:"url"`
+	Related                *Related                        `json:"related"`
+	SummaryFields          *WorkflowJobTemplateNodeSummary `json:"summary_fields"`
+	Created                time.Time                       `json:"created"`
+	Modified               time.Time                       `json:"modified"`
+	ExtraData              map[string]interface{}          `json:"extra_data"`
+	Inventory              int                             `json:"inventory"`
+	ScmBranch              string                          `json:"scm_branch"`
+	JobType                string                          `json:"job_type"`
+	JobTags                string                          `json:"job_tags"`
+	SkipTags               string                          `json:"skip_tags"`
+	Limit                  string                          `json:"limit"`
+	DiffMode               *bool                           `json:"diff_mode"`
+	Verbosity              *int                            `json:"verbosity"`
+	WorkflowJobTemplate    int                             `json:"workflow_job_template"`
+	UnifiedJobTemplate     int                             `json:"unified_job_template"`
+	SuccessNodes           []int                           `json:"success_nodes"`
+	FailureNodes           []int                           `json:"failure_nodes"`
+	AlwaysNodes            []int                           `json:"always_nodes"`
+	AllParentsMustConverge bool                            `json:"all_parents_must_converge"`
+	Identifier             string                          `json:"identifier"`
+}
+
+// ListWorkflowJobTemplateNodes represents `ListWorkflowJobTemplateNodes` endpoint response.
+type ListWorkflowJobTemplateNodes struct {
+	Pagination
+	Results []*WorkflowJobTemplateNode `json:"results"`
+}
+
+// WorkflowApprovalTemplate represents the awx api workflow approval template.
+type WorkflowApprovalTemplate struct {
+	ID            int       `json:"id"`
+	Type          string    `json:"type"`
+	URL           string    `json:"url"`
+	Related       *Related  `json:"related"`
+	SummaryFields *Summary  `json:"summary_fields"`
+	Created       time.Time `json:"created"`
+	Modified      time.Time `json:"modified"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description"`
+	Status        string    `json:"status"`
+	Timeout       int       `json:"timeout"`
+}
+
+// WorkflowApprovalSummary represents the awx api workflow approval summary fields.
+type WorkflowApprovalSummary struct {
+	WorkflowApprovalTemplate *UnifiedJobTemplate `json:"workflow_approval_template"`
+	SourceWorkflowJob        *UnifiedJobSummary  `json:"source_workflow_job"`
+	ApprovedOrDeniedBy       *ByUserSummary      `json:"approved_or_denied_by"`
+	CreatedBy                *ByUserSummary      `json:"created_by"`
+	UserCapabilities         *UserCapabilities   `json:"user_capabilities"`
+}
+
+// WorkflowApproval represents the awx api approval requested by a running workflow job.
+type WorkflowApproval struct {
+	ID                 int                      `json:"id"`
+	Type               string                   `json:"type"`
+	URL                string                   `json:"url"`
+	Related            *Related                 `json:"related"`
+	SummaryFields      *WorkflowApprovalSummary `json:"summary_fields"`
+	Created            time.Time                `json:"created"`
+	Modified           time.Time                `json:"modified"`
+	Name               string                   `json:"name"`
+	Description        string                   `json:"description"`
+	UnifiedJobTemplate int                      `json:"unified_job_template"`
+	LaunchType         string                   `json:"launch_type"`
+	Status             string                   `json:"status"`
+	Failed             bool                     `json:"failed"`
+	Started            time.Time                `json:"started"`
+	Finished           time.Time                `json:"finished"`
+	CanceledOn         time.Time                `json:"canceled_on"`
+	Elapsed            float64                  `json:"elapsed"`
+	JobExplanation     string                   `json:"job_explanation"`
+	CanApproveOrDeny   bool                     `json:"can_approve_or_deny"`
+	ApprovalExpiration *time.Time               `json:"approval_expiration"`
+	TimedOut           bool                     `json:"timed_out"`
+}
+
+// ListWorkflowApprovals represents `ListWorkflowApprovals` endpoint response.
+type ListWorkflowApprovals struct {
+	Pagination
+	Results []*WorkflowApproval `json:"results"`
+}
+
+// Schedule represents the awx api schedule.
+type Schedule struct {
+	ID                 int                    `json:"id"`
+	Type               string                 `json:"type"`
+	URL                string                 `json:"url"`
+	Related            *Related               `json:"related"`
+	SummaryFields      *Summary               `json:"summary_fields"`
+	Created            time.Time              `json:"created"`
+	Modified           time.Time              `json:"modified"`
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description"`
+	Rrule              string                 `json:"rrule"`
+	ExtraData          map[string]interface{} `json:"extra_data"`
+	Inventory          int                    `json:"inventory"`
+	ScmBranch          string                 `json:"scm_branch"`
+	JobType            string                 `json:"job_type"`
+	JobTags            string                 `json:"job_tags"`
+	SkipTags           string                 `json:"skip_tags"`
+	Limit              string                 `json:"limit"`
+	DiffMode           *bool                  `json:"diff_mode"`
+	Verbosity          *int                   `json:"verbosity"`
+	UnifiedJobTemplate int                    `json:"unified_job_template"`
+	Enabled            bool                   `json:"enabled"`
+	Dtstart            *time.Time             `json:"dtstart"`
+	Dtend              *time.Time             `json:"dtend"`
+	NextRun            *time.Time             `json:"next_run"`
+	Timezone           string                 `json:"timezone"`
+	Until              string                 `json:"until"`
+}
+
+// ListSchedules represents `ListSchedules` endpoint response.
+type ListSchedules struct {
+	Pagination
+	Results []*Schedule `json:"results"`
+}
+
+// SchedulePreview represents the awx api schedule preview response.
+type SchedulePreview struct {
+	Local []time.Time `json:"local"`
+	UTC   []time.Time `json:"utc"`
+}
+
 // HostSummaryHost represents the awx api host summary host fields.
 type HostSummaryHost struct {
 	ID                  int    `json:"id"`
@@ -697,14 +1222,142 @@ type JobEvents struct {
 	Results []JobEvent `json:"results"`
 }
 
+// AdHocCommand represents the awx api ad hoc command.
+type AdHocCommand struct {
+	ID                      int               `json:"id"`
+	Type                    string            `json:"type"`
+	URL                     string            `json:"url"`
+	Related                 *Related          `json:"related"`
+	SummaryFields           *Summary          `json:"summary_fields"`
+	Created                 time.Time         `json:"created"`
+	Modified                time.Time         `json:"modified"`
+	Name                    string            `json:"name"`
+	LaunchType              string            `json:"launch_type"`
+	Status                  string            `json:"status"`
+	Failed                  bool              `json:"failed"`
+	Started                 time.Time         `json:"started"`
+	Finished                time.Time         `json:"finished"`
+	CanceledOn              time.Time         `json:"canceled_on"`
+	Elapsed                 float64           `json:"elapsed"`
+	JobArgs                 string            `json:"job_args"`
+	JobCwd                  string            `json:"job_cwd"`
+	JobEnv                  map[string]string `json:"job_env"`
+	JobExplanation          string            `json:"job_explanation"`
+	ExecutionNode           string            `json:"execution_node"`
+	ResultTraceback         string            `json:"result_traceback"`
+	EventProcessingFinished bool              `json:"event_processing_finished"`
+	JobType                 string            `json:"job_type"`
+	Inventory               int               `json:"inventory"`
+	Limit                   string            `json:"limit"`
+	Credential              int               `json:"credential"`
+	ModuleName              string            `json:"module_name"`
+	ModuleArgs              string            `json:"module_args"`
+	Forks                   int               `json:"forks"`
+	Verbosity               int               `json:"verbosity"`
+	ExtraVars               string            `json:"extra_vars"`
+	BecomeEnabled           bool              `json:"become_enabled"`
+	DiffMode                bool              `json:"diff_mode"`
+	ExecutionEnvironment    int               `json:"execution_environment"`
+}
+
+// ListAdHocCommands represents `ListAdHocCommands` endpoint response.
+type ListAdHocCommands struct {
+	Pagination
+	Results []*AdHocCommand `json:"results"`
+}
+
+// SystemJobTemplate represents the awx api system job template.
+type SystemJobTemplate struct {
+	ID            int       `json:"id"`
+	Type          string    `json:"type"`
+	URL           string    `json:"url"`
+	Related       *Related  `json:"related"`
+	SummaryFields *Summary  `json:"summary_fields"`
+	Created       time.Time `json:"created"`
+	Modified      time.Time `json:"modified"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description"`
+	Status        string    `json:"status"`
+	JobType       string    `json:"job_type"`
+	LastJobFailed bool      `json:"last_job_failed"`
+	LastJobRun    time.Time `json:"last_job_run"`
+	NextJobRun    time.Time `json:"next_job_run"`
+}
+
+// SystemJob represents the awx api system job.
+type SystemJob struct {
+	ID                      int               `json:"id"`
+	Type                    string            `json:"type"`
+	URL                     string            `json:"url"`
+	Related                 *Related          `json:"related"`
+	SummaryFields           *Summary          `json:"summary_fields"`
+	Created                 time.Time         `json:"created"`
+	Modified                time.Time         `json:"modified"`
+	Name                    string            `json:"name"`
+	Description             string            `json:"description"`
+	UnifiedJobTemplate      int               `json:"unified_job_template"`
+	LaunchType              string            `json:"launch_type"`
+	Status                  string            `json:"status"`
+	Failed                  bool              `json:"failed"`
+	Started                 time.Time         `json:"started"`
+	Finished                time.Time         `json:"finished"`
+	CanceledOn              time.Time         `json:"canceled_on"`
+	Elapsed                 float64           `json:"elapsed"`
+	JobArgs                 string            `json:"job_args"`
+	JobCwd                  string            `json:"job_cwd"`
+	JobEnv                  map[string]string `json:"job_env"`
+	JobExplanation          string            `json:"job_explanation"`
+	ExecutionNode           string            `json:"execution_node"`
+	ResultTraceback         string            `json:"result_traceback"`
+	EventProcessingFinished bool              `json:"event_processing_finished"`
+	SystemJobTemplate       int               `json:"system_job_template"`
+	JobType                 string            `json:"job_type"`
+	ExtraVars               string            `json:"extra_vars"`
+	ResultStdout            string            `json:"result_stdout"`
+}
+
+// UnifiedJob represents the awx api unified job, any kind of job listed together.
+// Common fields are always filled, the rest is in the concrete job stored in Value.
+type UnifiedJob struct {
+	ID                 int       `json:"id"`
+	Type               string    `json:"type"`
+	URL                string    `json:"url"`
+	Related            *Related  `json:"related"`
+	SummaryFields      *Summary  `json:"summary_fields"`
+	Created            time.Time `json:"created"`
+	Modified           time.Time `json:"modified"`
+	Name               string    `json:"name"`
+	Description        string    `json:"description"`
+	UnifiedJobTemplate int       `json:"unified_job_template"`
+	LaunchType         string    `json:"launch_type"`
+	Status             string    `json:"status"`
+	Failed             bool      `json:"failed"`
+	Started            time.Time `json:"started"`
+	Finished           time.Time `json:"finished"`
+	CanceledOn         time.Time `json:"canceled_on"`
+	Elapsed            float64   `json:"elapsed"`
+	JobExplanation     string    `json:"job_explanation"`
+	ExecutionNode      string    `json:"execution_node"`
+	ResultTraceback    string    `json:"result_traceback"`
+	// Value is the job decoded by Type, see UnifiedJob.UnmarshalJSON.
+	Value interface{} `json:"-"`
+}
+
+// ListUnifiedJobs represents `ListUnifiedJobs` endpoint response.
+type ListUnifiedJobs struct {
+	Pagination
+	Results []*UnifiedJob `json:"results"`
+}
+
 // User represents an user
 type User struct {
 	ID              int         `json:"id"`
-	Type            int         `json:"type"`
+	Type            string      `json:"type"`
 	URL             string      `json:"url"`
 	Related         *Related    `json:"related"`
 	SummaryFields   *Summary    `json:"summary_fields"`
 	Created         time.Time   `json:"created"`
+	Modified        time.Time   `json:"modified"`
 	Username        string      `json:"username"`
 	FirstName       string      `json:"first_name"`
 	LastName        string      `json:"last_name"`
@@ -714,6 +1367,58 @@ type User struct {
 	Password        string      `json:"password"`
 	LdapDn          string      `json:"ldap_dn"`
 	ExternalAccount interface{} `json:"external_account"`
+	LastLogin       *time.Time  `json:"last_login"`
+}
+
+// ListUsers represents `ListUsers` endpoint response.
+type ListUsers struct {
+	Pagination
+	Results []*User `json:"results"`
+}
+
+// Team represents the awx api team.
+type Team struct {
+	ID            int       `json:"id"`
+	Type          string    `json:"type"`
+	URL           string    `json:"url"`
+	Related       *Related  `json:"related"`
+	SummaryFields *Summary  `json:"summary_fields"`
+	Created       time.Time `json:"created"`
+	Modified      time.Time `json:"modified"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description"`
+	Organization  int       `json:"organization"`
+}
+
+// ListTeams represents `ListTeams` endpoint response.
+type ListTeams struct {
+	Pagination
+	Results []*Team `json:"results"`
+}
+
+// RoleSummary represents the awx api role summary fields.
+type RoleSummary struct {
+	ResourceName            string `json:"resource_name"`
+	ResourceType            string `json:"resource_type"`
+	ResourceTypeDisplayName string `json:"resource_type_display_name"`
+	ResourceID              int    `json:"resource_id"`
+}
+
+// Role represents the awx api role.
+type Role struct {
+	ID            int          `json:"id"`
+	Type          string       `json:"type"`
+	URL           string       `json:"url"`
+	Related       *Related     `json:"related"`
+	SummaryFields *RoleSummary `json:"summary_fields"`
+	Name          string       `json:"name"`
+	Description   string       `json:"description"`
+}
+
+// ListRoles represents `ListRoles` endpoint response.
+type ListRoles struct {
+	Pagination
+	Results []*Role `json:"results"`
 }
 
 // Group represents a group
@@ -772,6 +1477,12 @@ type ListHosts struct {
 	Results []*Host `json:"results"`
 }
 
+// BulkHostCreateResponse represents the awx api bulk host create response.
+type BulkHostCreateResponse struct {
+	URL   string  `json:"url"`
+	Hosts []*Host `json:"hosts"`
+}
+
 // AssociateGroup implement the awx group association request
 type AssociateGroup struct {
 	ID        int  `json:"id"`
@@ -794,6 +1505,50 @@ func (l *ListOrganizations) GetByName(name string) (*Organization, bool) {
 	return nil, false
 }
 
+// GetByName returns a Project by 'Name' field case-insensitive.
+func (l *ListProjects) GetByName(name string) (*Project, bool) {
+	for _, projectRow := range l.Results {
+		if strings.EqualFold(projectRow.Name, name) {
+			return projectRow, true
+		}
+	}
+
+	return nil, false
+}
+
+// GetByName returns a Credential by 'Name' field case-insensitive.
+func (l *ListCredentials) GetByName(name string) (*Credential, bool) {
+	for _, credentialRow := range l.Results {
+		if strings.EqualFold(credentialRow.Name, name) {
+			return credentialRow, true
+		}
+	}
+
+	return nil, false
+}
+
+// GetByName returns a CredentialType by 'Name' field case-insensitive.
+func (l *ListCredentialTypes) GetByName(name string) (*CredentialType, bool) {
+	for _, typeRow := range l.Results {
+		if strings.EqualFold(typeRow.Name, name) {
+			return typeRow, true
+		}
+	}
+
+	return nil, false
+}
+
+// GetByName returns a WorkflowJobTemplate by 'Name' field case-insensitive.
+func (l *ListWorkflowJobTemplates) GetByName(name string) (*WorkflowJobTemplate, bool) {
+	for _, templateRow := range l.Results {
+		if strings.EqualFold(templateRow.Name, name) {
+			return templateRow, true
+		}
+	}
+
+	return nil, false
+}
+
 // GetByName returns an JobTemplate by 'Name' field case-insensitive.
 func (l *ListJobTemplates) GetByName(name string) (*JobTemplate, bool) {
 	for _, templateRow := range l.Results {
@@ -837,3 +1592,14 @@ func (l *ListGroups) GetByName(name string) (*Group, bool) {
 
 	return nil, false
 }
+
+// GetByName returns a Team by 'Name' field case-insensitive.
+func (l *ListTeams) GetByName(name string) (*Team, bool) {
+	for _, teamRow := range l.Results {
+		if strings.EqualFold(teamRow.Name, name) {
+			return teamRow, true
+		}
+	}
+
+	return nil, false
+}
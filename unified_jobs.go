@@ -0,0 +1,344 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Enum of unified job types.
+const (
+	UnifiedJobTypeJob              = "job"
+	UnifiedJobTypeProjectUpdate    = "project_update"
+	UnifiedJobTypeInventoryUpdate  = "inventory_update"
+	UnifiedJobTypeWorkflowJob      = "workflow_job"
+	UnifiedJobTypeWorkflowApproval = "workflow_approval"
+	UnifiedJobTypeAdHocCommand     = "ad_hoc_command"
+	UnifiedJobTypeSystemJob        = "system_job"
+)
+
+// Enum of unified job template types.
+const (
+	UnifiedJobTemplateTypeJobTemplate              = "job_template"
+	UnifiedJobTemplateTypeWorkflowJobTemplate      = "workflow_job_template"
+	UnifiedJobTemplateTypeProject                  = "project"
+	UnifiedJobTemplateTypeInventorySource          = "inventory_source"
+	UnifiedJobTemplateTypeSystemJobTemplate        = "system_job_template"
+	UnifiedJobTemplateTypeWorkflowApprovalTemplate = "workflow_approval_template"
+)
+
+// UnifiedJobFilter selects unified jobs, zero fields are not filtered.
+type UnifiedJobFilter struct {
+	// Types are the UnifiedJobType constants to include.
+	Types []string
+	// Statuses are the JobStatus constants to include.
+	Statuses []string
+	// UnifiedJobTemplate is the ID of the template which launched the jobs.
+	UnifiedJobTemplate int
+	// LaunchedBy is the ID of the user who launched the jobs.
+	LaunchedBy int
+	// LaunchedByUsername is the name of the user who launched the jobs.
+	LaunchedByUsername string
+	// TimeField is the time compared with Since and Until, `created` by default,
+	// `started` and `finished` are useful too.
+	TimeField string
+	Since     time.Time
+	Until     time.Time
+	// OrderBy sorts the jobs, `-created` by default.
+	OrderBy []string
+}
+
+// Query renders the filter as a query, several Types repeat the `or__type` key.
+// It can be passed to any unified job list method.
+func (f *UnifiedJobFilter) Query() Query {
+	query := NewQuery()
+	if f == nil {
+		return query.OrderBy("-created")
+	}
+
+	switch len(f.Types) {
+	case 0:
+	case 1:
+		query.Where("type", f.Types[0])
+	default:
+		for _, jobType := range f.Types {
+			query.Or("type", LookupExact, jobType)
+		}
+	}
+
+	if len(f.Statuses) > 0 {
+		statuses := make([]interface{}, 0, len(f.Statuses))
+		for _, status := range f.Statuses {
+			statuses = append(statuses, status)
+		}
+		query.In("status", statuses...)
+	}
+
+	if f.UnifiedJobTemplate != 0 {
+		query.Where("unified_job_template", f.UnifiedJobTemplate)
+	}
+	if f.LaunchedBy != 0 {
+		query.Where("created_by", f.LaunchedBy)
+	}
+	if f.LaunchedByUsername != "" {
+		query.Where("created_by__username", f.LaunchedByUsername)
+	}
+
+	field := f.TimeField
+	if field == "" {
+		field = "created"
+	}
+	if !f.Since.IsZero() {
+		query.Filter(field, LookupGTE, f.Since)
+	}
+	if !f.Until.IsZero() {
+		query.Filter(field, LookupLT, f.Until)
+	}
+
+	if len(f.OrderBy) > 0 {
+		query.OrderBy(f.OrderBy...)
+	} else {
+		query.OrderBy("-created")
+	}
+
+	return query
+}
+
+// UnifiedJobService implements awx unified job and unified job template apis.
+type UnifiedJobService struct {
+	Requester *Requester
+}
+
+// ListUnifiedJobs shows a list of jobs of every type.
+func (u *UnifiedJobService) ListUnifiedJobs(ctx context.Context, params ListParams) (*ListUnifiedJobs, error) {
+	result := ListUnifiedJobs{}
+	endpoint := "/api/v2/unified_jobs/"
+
+	_, err := u.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UnifiedJobsIter returns an iterator over all jobs of every type, following pagination.
+func (u *UnifiedJobService) UnifiedJobsIter(params ListParams, opts *ListOptions) *Iterator[*UnifiedJob] {
+	return newIterator[*UnifiedJob](u.Requester, "/api/v2/unified_jobs/", params, opts)
+}
+
+// ListAllUnifiedJobs shows a list of jobs of every type from every page.
+func (u *UnifiedJobService) ListAllUnifiedJobs(ctx context.Context, params ListParams, opts *ListOptions) ([]*UnifiedJob, error) {
+	return u.UnifiedJobsIter(params, opts).All(ctx)
+}
+
+// FindUnifiedJobs shows the jobs matching the filter from every page.
+//
+//	jobs, err := client.UnifiedJobService.FindUnifiedJobs(ctx, &awx.UnifiedJobFilter{
+//		Statuses: []string{awx.JobStatusFailed, awx.JobStatusError},
+//		Since:    time.Now().Add(-24 * time.Hour),
+//	}, nil)
+func (u *UnifiedJobService) FindUnifiedJobs(ctx context.Context, filter *UnifiedJobFilter, opts *ListOptions) ([]*UnifiedJob, error) {
+	return u.ListAllUnifiedJobs(ctx, filter.Query(), opts)
+}
+
+// ListUnifiedJobTemplates shows a list of templates of every type.
+func (u *UnifiedJobService) ListUnifiedJobTemplates(ctx context.Context, params ListParams) (*ListUnifiedJobTemplates, error) {
+	result := ListUnifiedJobTemplates{}
+	endpoint := "/api/v2/unified_job_templates/"
+
+	_, err := u.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UnifiedJobTemplatesIter returns an iterator over all templates of every type, following pagination.
+func (u *UnifiedJobService) UnifiedJobTemplatesIter(params ListParams, opts *ListOptions) *Iterator[*UnifiedJobTemplate] {
+	return newIterator[*UnifiedJobTemplate](u.Requester, "/api/v2/unified_job_templates/", params, opts)
+}
+
+// ListAllUnifiedJobTemplates shows a list of templates of every type from every page.
+func (u *UnifiedJobService) ListAllUnifiedJobTemplates(ctx context.Context, params ListParams, opts *ListOptions) ([]*UnifiedJobTemplate, error) {
+	return u.UnifiedJobTemplatesIter(params, opts).All(ctx)
+}
+
+// GetUnifiedJobTemplate retrives the unified job template information from its ID.
+// awx has no detail endpoint for unified job templates, the list is filtered by ID
+// and an *APIError with 404 status is returned when it is empty, see IsNotFound.
+func (u *UnifiedJobService) GetUnifiedJobTemplate(ctx context.Context, id int) (*UnifiedJobTemplate, error) {
+	list, err := u.ListUnifiedJobTemplates(ctx, map[string]string{
+		"id": strconv.Itoa(id),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list.Results) == 0 {
+		return nil, &APIError{
+			StatusCode: http.StatusNotFound,
+			Method:     http.MethodGet,
+			URL:        fmt.Sprintf("%s/api/v2/unified_job_templates/?id=%d", u.Requester.Base, id),
+			Detail:     fmt.Sprintf("unified job template %d is not found", id),
+		}
+	}
+
+	return list.Results[0], nil
+}
+
+// UnmarshalJSON decodes the common fields and the concrete job by its type into Value:
+// *Job, *ProjectUpdate, *InventoryUpdate, *WorkflowJob, *WorkflowApproval, *AdHocCommand
+// or *SystemJob. Value is nil for unknown types.
+func (j *UnifiedJob) UnmarshalJSON(data []byte) error {
+	type unifiedJob UnifiedJob
+	if err := json.Unmarshal(data, (*unifiedJob)(j)); err != nil {
+		return err
+	}
+
+	var value interface{}
+	switch j.Type {
+	case UnifiedJobTypeJob:
+		value = &Job{}
+	case UnifiedJobTypeProjectUpdate:
+		value = &ProjectUpdate{}
+	case UnifiedJobTypeInventoryUpdate:
+		value = &InventoryUpdate{}
+	case UnifiedJobTypeWorkflowJob:
+		value = &WorkflowJob{}
+	case UnifiedJobTypeWorkflowApproval:
+		value = &WorkflowApproval{}
+	case UnifiedJobTypeAdHocCommand:
+		value = &AdHocCommand{}
+	case UnifiedJobTypeSystemJob:
+		value = &SystemJob{}
+	default:
+		j.Value = nil
+		return nil
+	}
+
+	if err := json.Unmarshal(data, value); err != nil {
+		return fmt.Errorf("%s %d: %w", j.Type, j.ID, err)
+	}
+	j.Value = value
+
+	return nil
+}
+
+// Job returns the job when the unified job is a playbook run.
+func (j *UnifiedJob) Job() (*Job, bool) {
+	value, ok := j.Value.(*Job)
+	return value, ok
+}
+
+// ProjectUpdate returns the project update when the unified job is one.
+func (j *UnifiedJob) ProjectUpdate() (*ProjectUpdate, bool) {
+	value, ok := j.Value.(*ProjectUpdate)
+	return value, ok
+}
+
+// InventoryUpdate returns the inventory update when the unified job is one.
+func (j *UnifiedJob) InventoryUpdate() (*InventoryUpdate, bool) {
+	value, ok := j.Value.(*InventoryUpdate)
+	return value, ok
+}
+
+// WorkflowJob returns the workflow job when the unified job is one.
+func (j *UnifiedJob) WorkflowJob() (*WorkflowJob, bool) {
+	value, ok := j.Value.(*WorkflowJob)
+	return value, ok
+}
+
+// WorkflowApproval returns the workflow approval when the unified job is one.
+func (j *UnifiedJob) WorkflowApproval() (*WorkflowApproval, bool) {
+	value, ok := j.Value.(*WorkflowApproval)
+	return value, ok
+}
+
+// AdHocCommand returns the ad hoc command when the unified job is one.
+func (j *UnifiedJob) AdHocCommand() (*AdHocCommand, bool) {
+	value, ok := j.Value.(*AdHocCommand)
+	return value, ok
+}
+
+// SystemJob returns the system job when the unified job is one.
+func (j *UnifiedJob) SystemJob() (*SystemJob, bool) {
+	value, ok := j.Value.(*SystemJob)
+	return value, ok
+}
+
+// UnmarshalJSON decodes the common fields and the concrete template by its type into Value:
+// *JobTemplate, *WorkflowJobTemplate, *Project, *InventorySource, *SystemJobTemplate
+// or *WorkflowApprovalTemplate. Value is nil for the summary form and unknown types.
+func (t *UnifiedJobTemplate) UnmarshalJSON(data []byte) error {
+	type unifiedJobTemplate UnifiedJobTemplate
+	if err := json.Unmarshal(data, (*unifiedJobTemplate)(t)); err != nil {
+		return err
+	}
+
+	var value interface{}
+	switch t.Type {
+	case UnifiedJobTemplateTypeJobTemplate:
+		value = &JobTemplate{}
+	case UnifiedJobTemplateTypeWorkflowJobTemplate:
+		value = &WorkflowJobTemplate{}
+	case UnifiedJobTemplateTypeProject:
+		value = &Project{}
+	case UnifiedJobTemplateTypeInventorySource:
+		value = &InventorySource{}
+	case UnifiedJobTemplateTypeSystemJobTemplate:
+		value = &SystemJobTemplate{}
+	case UnifiedJobTemplateTypeWorkflowApprovalTemplate:
+		value = &WorkflowApprovalTemplate{}
+	default:
+		t.Value = nil
+		return nil
+	}
+
+	if err := json.Unmarshal(data, value); err != nil {
+		return fmt.Errorf("%s %d: %w", t.Type, t.ID, err)
+	}
+	t.Value = value
+
+	return nil
+}
+
+// JobTemplate returns the job template when the unified job template is one.
+func (t *UnifiedJobTemplate) JobTemplate() (*JobTemplate, bool) {
+	value, ok := t.Value.(*JobTemplate)
+	return value, ok
+}
+
+// WorkflowJobTemplate returns the workflow job template when the unified job template is one.
+func (t *UnifiedJobTemplate) WorkflowJobTemplate() (*WorkflowJobTemplate, bool) {
+	value, ok := t.Value.(*WorkflowJobTemplate)
+	return value, ok
+}
+
+// Project returns the project when the unified job template is one.
+func (t *UnifiedJobTemplate) Project() (*Project, bool) {
+	value, ok := t.Value.(*Project)
+	return value, ok
+}
+
+// InventorySource returns the inventory source when the unified job template is one.
+func (t *UnifiedJobTemplate) InventorySource() (*InventorySource, bool) {
+	value, ok := t.Value.(*InventorySource)
+	return value, ok
+}
+
+// SystemJobTemplate returns the system job template when the unified job template is one.
+func (t *UnifiedJobTemplate) SystemJobTemplate() (*SystemJobTemplate, bool) {
+	value, ok := t.Value.(*SystemJobTemplate)
+	return value, ok
+}
+
+// WorkflowApprovalTemplate returns the workflow approval template when the unified job template is one.
+func (t *UnifiedJobTemplate) WorkflowApprovalTemplate() (*WorkflowApprovalTemplate, bool) {
+	value, ok := t.Value.(*WorkflowApprovalTemplate)
+	return value, ok
+}
@@ -0,0 +1,163 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+)
+
+// UserService implements awx user apis.
+type UserService struct {
+	Requester *Requester
+}
+
+// ListUsers shows a list of users.
+func (u *UserService) ListUsers(ctx context.Context, params ListParams) (*ListUsers, error) {
+	result := ListUsers{}
+	endpoint := "/api/v2/users/"
+
+	_, err := u.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UsersIter returns an iterator over all users, following pagination.
+func (u *UserService) UsersIter(params ListParams, opts *ListOptions) *Iterator[*User] {
+	return newIterator[*User](u.Requester, "/api/v2/users/", params, opts)
+}
+
+// ListAllUsers shows a list of users from every page.
+func (u *UserService) ListAllUsers(ctx context.Context, params ListParams, opts *ListOptions) ([]*User, error) {
+	return u.UsersIter(params, opts).All(ctx)
+}
+
+// GetUser retrives the user information from its ID.
+func (u *UserService) GetUser(ctx context.Context, id int) (*User, error) {
+	result := User{}
+	endpoint := fmt.Sprintf("/api/v2/users/%d/", id)
+
+	_, err := u.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetUserByUsername retrives the user information from its username.
+func (u *UserService) GetUserByUsername(ctx context.Context, username string) (*User, error) {
+	list, err := u.ListUsers(ctx, map[string]string{
+		"username": username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list.Results) == 0 {
+		return nil, fmt.Errorf("user %q is not found", username)
+	}
+
+	return list.Results[0], nil
+}
+
+// CreateUser creates an user.
+//
+//	username TEXT *REQUIRED
+//	password TEXT *REQUIRED
+//	first_name TEXT
+//	last_name TEXT
+//	email TEXT
+//	is_superuser BOOLEAN
+//	is_system_auditor BOOLEAN
+func (u *UserService) CreateUser(ctx context.Context, data map[string]interface{}) (*User, error) {
+	result := User{}
+	endpoint := "/api/v2/users/"
+
+	validate, status := ValidateParams(data, []string{"username", "password"})
+	if !status {
+		return nil, fmt.Errorf("mandatory input arguments are absent: %s", validate)
+	}
+
+	_, err := u.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateUser update an user.
+func (u *UserService) UpdateUser(ctx context.Context, id int, data map[string]interface{}) (*User, error) {
+	result := User{}
+	endpoint := fmt.Sprintf("/api/v2/users/%d", id)
+
+	_, err := u.Requester.Patch(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteUser delete an user.
+func (u *UserService) DeleteUser(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/users/%d", id)
+
+	_, err := u.Requester.Delete(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListUserTeams shows the teams the user is member of.
+func (u *UserService) ListUserTeams(ctx context.Context, id int, params ListParams) (*ListTeams, error) {
+	result := ListTeams{}
+	endpoint := fmt.Sprintf("/api/v2/users/%d/teams/", id)
+
+	_, err := u.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListUserOrganizations shows the organizations the user is member of.
+func (u *UserService) ListUserOrganizations(ctx context.Context, id int, params ListParams) (*ListOrganizations, error) {
+	result := ListOrganizations{}
+	endpoint := fmt.Sprintf("/api/v2/users/%d/organizations/", id)
+
+	_, err := u.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListUserRoles shows the roles granted to the user.
+func (u *UserService) ListUserRoles(ctx context.Context, id int, params ListParams) (*ListRoles, error) {
+	result := ListRoles{}
+	endpoint := fmt.Sprintf("/api/v2/users/%d/roles/", id)
+
+	_, err := u.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GrantRole grants the role to the user, see RoleService.GetObjectRole to find its ID.
+func (u *UserService) GrantRole(ctx context.Context, id int, roleID int) error {
+	return associate(ctx, u.Requester, fmt.Sprintf("/api/v2/users/%d/roles/", id), roleID, false)
+}
+
+// RevokeRole revokes the role from the user.
+func (u *UserService) RevokeRole(ctx context.Context, id int, roleID int) error {
+	return associate(ctx, u.Requester, fmt.Sprintf("/api/v2/users/%d/roles/", id), roleID, true)
+}
@@ -2,81 +2,137 @@ package awx
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 )
 
-// WaitForJobFinish ожидает что у задания будет один из статусов, указывающих на завершение задания.
-// Перечень статусов:
-// successful
-// failed
-// error
-// canceled
-func WaitForSuccessJobFinish(c *Client, id int, secs int) error {
-	return waitFor(secs, func() (bool, error) {
+// WaitOptions configures polling of the Wait methods.
+type WaitOptions struct {
+	// Interval is the delay between polls, 1 second by default.
+	Interval time.Duration
+	// Backoff multiplies the interval after every poll, values up to 1 keep it constant.
+	Backoff float64
+	// MaxInterval caps the interval grown by Backoff.
+	MaxInterval time.Duration
+	// OnStatusChange is called on every observed status transition,
+	// previous is empty on the first poll.
+	OnStatusChange func(id int, previous string, current string)
+}
 
-		job, err := c.JobService.GetJob(context.Background(), id, map[string]string{})
-		if err != nil {
-			return false, err
-		}
+// JobFailedError is returned by the Wait methods when a job finished
+// with one of failed, error or canceled statuses.
+type JobFailedError struct {
+	Type            string
+	ID              int
+	Status          string
+	JobExplanation  string
+	ResultTraceback string
+}
 
-		switch job.Status {
-		case JobStatusSuccessful:
-			return true, nil
-		case JobStatusFailed:
-			return true, fmt.Errorf("task finished with bad status: %s", JobStatusFailed)
-		case JobStatusError:
-			return true, fmt.Errorf("task finished with bad status: %s", JobStatusError)
-		case JobStatusCanceled:
-			return true, fmt.Errorf("task finished with bad status: %s", JobStatusCanceled)
-		}
+func (e *JobFailedError) Error() string {
+	msg := fmt.Sprintf("%s %d finished with bad status: %s", e.Type, e.ID, e.Status)
+	if e.JobExplanation != "" {
+		msg += ": " + e.JobExplanation
+	}
 
-		return false, nil
-	})
+	return msg
 }
 
-// WaitFor polls a predicate function, once per second, up to a timeout limit.
-// This is useful to wait for a resource to transition to a certain state.
-// To handle situations when the predicate might hang indefinitely, the
-// predicate will be prematurely cancelled after the timeout.
-// Resource packages will wrap this in a more convenient function that's
-// specific to a certain resource, but it can also be useful on its own.
-func waitFor(timeout int, predicate func() (bool, error)) error {
-	type WaitForResult struct {
-		Success bool
-		Error   error
+// IsFinishedStatus reports whether the job status is final.
+func IsFinishedStatus(status string) bool {
+	switch status {
+	case JobStatusSuccessful, JobStatusFailed, JobStatusError, JobStatusCanceled:
+		return true
 	}
 
-	start := time.Now().Unix()
+	return false
+}
+
+// jobState is the part of any unified job the waiting depends on.
+type jobState struct {
+	Type            string
+	Status          string
+	JobExplanation  string
+	ResultTraceback string
+}
 
+// waitFor polls get until the job reaches a finished status or ctx is done.
+// The last fetched value is returned along with the error.
+func waitFor[T any](ctx context.Context, id int, opts *WaitOptions, get func(ctx context.Context) (T, jobState, error)) (T, error) {
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	var previous string
 	for {
-		// If a timeout is set, and that's been exceeded, shut it down.
-		if timeout >= 0 && time.Now().Unix()-start >= int64(timeout) {
-			return fmt.Errorf("a timeout occurred")
+		value, state, err := get(ctx)
+		if err != nil {
+			return value, err
 		}
 
-		time.Sleep(1 * time.Second)
-
-		var result WaitForResult
-		ch := make(chan bool, 1)
-		go func() {
-			defer close(ch)
-			satisfied, err := predicate()
-			result.Success = satisfied
-			result.Error = err
-		}()
-
-		select {
-		case <-ch:
-			if result.Error != nil {
-				return result.Error
+		if state.Status != previous && opts.OnStatusChange != nil {
+			opts.OnStatusChange(id, previous, state.Status)
+		}
+		previous = state.Status
+
+		switch state.Status {
+		case JobStatusSuccessful:
+			return value, nil
+		case JobStatusFailed, JobStatusError, JobStatusCanceled:
+			return value, &JobFailedError{
+				Type:            state.Type,
+				ID:              id,
+				Status:          state.Status,
+				JobExplanation:  state.JobExplanation,
+				ResultTraceback: state.ResultTraceback,
 			}
-			if result.Success {
-				return nil
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return value, err
+		}
+
+		if opts.Backoff > 1 {
+			interval = time.Duration(float64(interval) * opts.Backoff)
+			if opts.MaxInterval > 0 && interval > opts.MaxInterval {
+				interval = opts.MaxInterval
 			}
-		// If the predicate has not finished by the timeout, cancel it.
-		case <-time.After(time.Duration(timeout) * time.Second):
-			return fmt.Errorf("a timeout occurred")
 		}
 	}
 }
+
+// WaitForJobFinish ожидает что у задания будет один из статусов, указывающих на завершение задания.
+// Перечень статусов:
+// successful
+// failed
+// error
+// canceled
+//
+// Deprecated: use JobService.Wait, it respects the caller context and returns the job.
+func WaitForSuccessJobFinish(c *Client, id int, secs int) error {
+	ctx := context.Background()
+	if secs >= 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(secs)*time.Second)
+		defer cancel()
+	}
+
+	_, err := c.JobService.Wait(ctx, id, nil)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("a timeout occurred")
+	}
+
+	// The error texts are kept as they were before JobFailedError.
+	var failed *JobFailedError
+	if errors.As(err, &failed) {
+		return fmt.Errorf("task finished with bad status: %s", failed.Status)
+	}
+
+	return err
+}
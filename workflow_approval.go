@@ -0,0 +1,187 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// WorkflowApprovalService implements awx workflow approval apis.
+type WorkflowApprovalService struct {
+	Requester *Requester
+}
+
+// PendingApprovalsFilter narrows the list of pending approvals, zero values are not applied.
+type PendingApprovalsFilter struct {
+	// WorkflowJob is the ID of the workflow job waiting for the approval.
+	WorkflowJob int
+	// WorkflowJobTemplate is the ID of the template the workflow job was launched from.
+	WorkflowJobTemplate int
+	// OlderThan keeps approvals requested at least that long ago.
+	OlderThan time.Duration
+	// CreatedBy is the username of the user who launched the workflow.
+	CreatedBy string
+}
+
+func (f *PendingApprovalsFilter) params() map[string]string {
+	params := map[string]string{
+		"status": JobStatusPending,
+	}
+
+	if f == nil {
+		return params
+	}
+
+	if f.WorkflowJob != 0 {
+		params["unified_job_node__workflow_job"] = strconv.Itoa(f.WorkflowJob)
+	}
+	if f.WorkflowJobTemplate != 0 {
+		params["unified_job_node__workflow_job__workflow_job_template"] = strconv.Itoa(f.WorkflowJobTemplate)
+	}
+	if f.OlderThan > 0 {
+		params["created__lt"] = time.Now().Add(-f.OlderThan).UTC().Format(time.RFC3339)
+	}
+	if f.CreatedBy != "" {
+		params["created_by__username"] = f.CreatedBy
+	}
+
+	return params
+}
+
+// ListWorkflowApprovals shows a list of workflow approvals.
+func (wa *WorkflowApprovalService) ListWorkflowApprovals(ctx context.Context, params ListParams) (*ListWorkflowApprovals, error) {
+	result := ListWorkflowApprovals{}
+	endpoint := "/api/v2/workflow_approvals/"
+
+	_, err := wa.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// WorkflowApprovalsIter returns an iterator over all workflow approvals, following pagination.
+func (wa *WorkflowApprovalService) WorkflowApprovalsIter(params ListParams, opts *ListOptions) *Iterator[*WorkflowApproval] {
+	return newIterator[*WorkflowApproval](wa.Requester, "/api/v2/workflow_approvals/", params, opts)
+}
+
+// ListPendingApprovals shows the approvals still waiting for a decision from every page.
+func (wa *WorkflowApprovalService) ListPendingApprovals(ctx context.Context, filter *PendingApprovalsFilter) ([]*WorkflowApproval, error) {
+	return wa.WorkflowApprovalsIter(filter.params(), nil).All(ctx)
+}
+
+// GetWorkflowApproval shows the details of a workflow approval.
+func (wa *WorkflowApprovalService) GetWorkflowApproval(ctx context.Context, id int) (*WorkflowApproval, error) {
+	result := WorkflowApproval{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_approvals/%d/", id)
+
+	_, err := wa.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Approve approves a pending workflow approval, the workflow continues with its success nodes.
+func (wa *WorkflowApprovalService) Approve(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/workflow_approvals/%d/approve/", id)
+
+	_, err := wa.Requester.Post(ctx, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Deny denies a pending workflow approval, the workflow continues with its failure nodes.
+func (wa *WorkflowApprovalService) Deny(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/workflow_approvals/%d/deny/", id)
+
+	_, err := wa.Requester.Post(ctx, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WaitForDecision polls the approval until it is approved, denied, timed out or canceled.
+// Unlike the job Wait methods a denial is not an error, check the returned approval
+// with Approved, Denied and TimedOut.
+// The wait is bounded by ctx only, use context.WithTimeout to limit it.
+func (wa *WorkflowApprovalService) WaitForDecision(ctx context.Context, id int, opts *WaitOptions) (*WorkflowApproval, error) {
+	approval, err := waitFor(ctx, id, opts, func(ctx context.Context) (*WorkflowApproval, jobState, error) {
+		approval, err := wa.GetWorkflowApproval(ctx, id)
+		if err != nil {
+			return nil, jobState{}, err
+		}
+
+		return approval, jobState{
+			Type:           "workflow_approval",
+			Status:         approval.Status,
+			JobExplanation: approval.JobExplanation,
+		}, nil
+	})
+
+	var failed *JobFailedError
+	if errors.As(err, &failed) {
+		return approval, nil
+	}
+
+	return approval, err
+}
+
+// GetWorkflowApprovalTemplate shows the details of an approval template.
+func (wa *WorkflowApprovalService) GetWorkflowApprovalTemplate(ctx context.Context, id int) (*WorkflowApprovalTemplate, error) {
+	result := WorkflowApprovalTemplate{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_approval_templates/%d/", id)
+
+	_, err := wa.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListApprovalsByTemplateID shows the approvals requested by an approval template.
+func (wa *WorkflowApprovalService) ListApprovalsByTemplateID(ctx context.Context, id int, params ListParams) (*ListWorkflowApprovals, error) {
+	result := ListWorkflowApprovals{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_approval_templates/%d/approvals/", id)
+
+	_, err := wa.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Pending reports whether the approval still waits for a decision.
+func (a *WorkflowApproval) Pending() bool {
+	return !IsFinishedStatus(a.Status)
+}
+
+// Approved reports whether the approval was approved.
+func (a *WorkflowApproval) Approved() bool {
+	return a.Status == JobStatusSuccessful
+}
+
+// Denied reports whether the approval was denied by a user.
+func (a *WorkflowApproval) Denied() bool {
+	return a.Status == JobStatusFailed && !a.TimedOut
+}
+
+// DecidedBy returns the username of the user who approved or denied, empty otherwise.
+func (a *WorkflowApproval) DecidedBy() string {
+	if a.SummaryFields == nil || a.SummaryFields.ApprovedOrDeniedBy == nil {
+		return ""
+	}
+
+	return a.SummaryFields.ApprovedOrDeniedBy.Username
+}
@@ -0,0 +1,651 @@
+package awx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WorkflowApprovalSpec describes an approval gate of a workflow node.
+type WorkflowApprovalSpec struct {
+	Name        string
+	Description string
+	// Timeout is in seconds, zero means the approval never expires.
+	Timeout int
+}
+
+// WorkflowNode is a node of WorkflowGraph.
+// Nodes are matched between graphs by Identifier, the same field AWX keeps on every node.
+type WorkflowNode struct {
+	Identifier string
+	// ID of the awx node, set only for nodes read from awx.
+	ID int
+	// UnifiedJobTemplate is the ID of the job template, project, inventory source
+	// or workflow job template run by the node.
+	UnifiedJobTemplate int
+	// Approval makes the node an approval gate, UnifiedJobTemplate is ignored then.
+	Approval *WorkflowApprovalSpec
+
+	AllParentsMustConverge bool
+	ExtraData              map[string]interface{}
+	Inventory              int
+	Limit                  string
+	ScmBranch              string
+	JobType                string
+	JobTags                string
+	SkipTags               string
+	DiffMode               *bool
+	Verbosity              *int
+
+	// Children identifiers by the outcome of this node.
+	SuccessNodes []string
+	FailureNodes []string
+	AlwaysNodes  []string
+}
+
+// WorkflowEdge is a directed edge between two nodes of WorkflowGraph.
+type WorkflowEdge struct {
+	Parent string
+	Child  string
+	// Kind is one of WorkflowEdgeSuccess, WorkflowEdgeFailure or WorkflowEdgeAlways.
+	Kind string
+}
+
+// WorkflowGraph is the Go side model of workflow job template nodes.
+//
+//	graph := awx.NewWorkflowGraph()
+//	graph.AddNode("sync", projectID)
+//	graph.AddNode("deploy", jobTemplateID).Limit = "web"
+//	graph.AddApprovalNode("approve", "Approve production", 3600)
+//	graph.OnSuccess("sync", "approve").OnSuccess("approve", "deploy")
+type WorkflowGraph struct {
+	Nodes []*WorkflowNode
+}
+
+// NewWorkflowGraph creates an empty graph.
+func NewWorkflowGraph() *WorkflowGraph {
+	return &WorkflowGraph{
+		Nodes: make([]*WorkflowNode, 0),
+	}
+}
+
+// AddNode adds a node running the unified job template and returns it to set prompts.
+func (g *WorkflowGraph) AddNode(identifier string, unifiedJobTemplate int) *WorkflowNode {
+	node := &WorkflowNode{
+		Identifier:         identifier,
+		UnifiedJobTemplate: unifiedJobTemplate,
+	}
+	g.Nodes = append(g.Nodes, node)
+
+	return node
+}
+
+// AddApprovalNode adds an approval gate with the timeout in seconds.
+func (g *WorkflowGraph) AddApprovalNode(identifier string, name string, timeout int) *WorkflowNode {
+	node := &WorkflowNode{
+		Identifier: identifier,
+		Approval: &WorkflowApprovalSpec{
+			Name:    name,
+			Timeout: timeout,
+		},
+	}
+	g.Nodes = append(g.Nodes, node)
+
+	return node
+}
+
+// Node returns the node by its identifier.
+func (g *WorkflowGraph) Node(identifier string) (*WorkflowNode, bool) {
+	for _, node := range g.Nodes {
+		if node.Identifier == identifier {
+			return node, true
+		}
+	}
+
+	return nil, false
+}
+
+// OnSuccess runs the child node when the parent one succeeds.
+// Unknown identifiers are reported by Validate.
+func (g *WorkflowGraph) OnSuccess(parent string, child string) *WorkflowGraph {
+	return g.link(parent, child, WorkflowEdgeSuccess)
+}
+
+// OnFailure runs the child node when the parent one fails.
+func (g *WorkflowGraph) OnFailure(parent string, child string) *WorkflowGraph {
+	return g.link(parent, child, WorkflowEdgeFailure)
+}
+
+// Always runs the child node whatever the outcome of the parent one is.
+func (g *WorkflowGraph) Always(parent string, child string) *WorkflowGraph {
+	return g.link(parent, child, WorkflowEdgeAlways)
+}
+
+func (g *WorkflowGraph) link(parent string, child string, kind string) *WorkflowGraph {
+	node, ok := g.Node(parent)
+	if !ok {
+		// Keep the edge on a placeholder, so Validate reports it.
+		node = &WorkflowNode{Identifier: parent}
+		g.Nodes = append(g.Nodes, node)
+	}
+
+	children := node.children(kind)
+	for _, existing := range *children {
+		if existing == child {
+			return g
+		}
+	}
+	*children = append(*children, child)
+
+	return g
+}
+
+func (n *WorkflowNode) children(kind string) *[]string {
+	switch kind {
+	case WorkflowEdgeFailure:
+		return &n.FailureNodes
+	case WorkflowEdgeAlways:
+		return &n.AlwaysNodes
+	}
+
+	return &n.SuccessNodes
+}
+
+// Edges returns every edge of the graph in a stable order.
+func (g *WorkflowGraph) Edges() []WorkflowEdge {
+	result := make([]WorkflowEdge, 0)
+	for _, node := range g.Nodes {
+		for _, kind := range []string{WorkflowEdgeSuccess, WorkflowEdgeFailure, WorkflowEdgeAlways} {
+			for _, child := range *node.children(kind) {
+				result = append(result, WorkflowEdge{Parent: node.Identifier, Child: child, Kind: kind})
+			}
+		}
+	}
+
+	sortEdges(result)
+
+	return result
+}
+
+// Roots returns the nodes started with the workflow.
+func (g *WorkflowGraph) Roots() []*WorkflowNode {
+	children := map[string]bool{}
+	for _, edge := range g.Edges() {
+		children[edge.Child] = true
+	}
+
+	result := make([]*WorkflowNode, 0)
+	for _, node := range g.Nodes {
+		if !children[node.Identifier] {
+			result = append(result, node)
+		}
+	}
+
+	return result
+}
+
+// Validate checks identifiers, node kinds and that the graph has no cycles.
+func (g *WorkflowGraph) Validate() error {
+	problems := make([]string, 0)
+
+	seen := map[string]bool{}
+	for _, node := range g.Nodes {
+		if node.Identifier == "" {
+			problems = append(problems, "node without identifier")
+			continue
+		}
+		if seen[node.Identifier] {
+			problems = append(problems, fmt.Sprintf("duplicate node %q", node.Identifier))
+		}
+		seen[node.Identifier] = true
+
+		if node.Approval == nil && node.UnifiedJobTemplate == 0 {
+			problems = append(problems, fmt.Sprintf("node %q runs nothing", node.Identifier))
+		}
+	}
+
+	for _, edge := range g.Edges() {
+		if !seen[edge.Child] {
+			problems = append(problems, fmt.Sprintf("edge %q -> %q points to unknown node", edge.Parent, edge.Child))
+		}
+	}
+
+	if cycle := g.findCycle(); cycle != nil {
+		problems = append(problems, fmt.Sprintf("cycle %s", strings.Join(cycle, " -> ")))
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid workflow graph: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func (g *WorkflowGraph) findCycle() []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := map[string]int{}
+	path := make([]string, 0)
+
+	var visit func(identifier string) []string
+	visit = func(identifier string) []string {
+		switch state[identifier] {
+		case visiting:
+			for i, step := range path {
+				if step == identifier {
+					return append(append([]string{}, path[i:]...), identifier)
+				}
+			}
+		case visited:
+			return nil
+		}
+
+		state[identifier] = visiting
+		path = append(path, identifier)
+
+		if node, ok := g.Node(identifier); ok {
+			for _, kind := range []string{WorkflowEdgeSuccess, WorkflowEdgeFailure, WorkflowEdgeAlways} {
+				for _, child := range *node.children(kind) {
+					if cycle := visit(child); cycle != nil {
+						return cycle
+					}
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[identifier] = visited
+
+		return nil
+	}
+
+	for _, node := range g.Nodes {
+		if state[node.Identifier] == unvisited {
+			if cycle := visit(node.Identifier); cycle != nil {
+				return cycle
+			}
+		}
+	}
+
+	return nil
+}
+
+// WorkflowGraphDiff is the list of changes converging one graph into another.
+type WorkflowGraphDiff struct {
+	Create []*WorkflowNode
+	// Update holds desired nodes with ID of the existing ones.
+	Update []*WorkflowNode
+	Delete []*WorkflowNode
+	Link   []WorkflowEdge
+	Unlink []WorkflowEdge
+
+	// current nodes by identifier, needed to apply the diff.
+	current map[string]*WorkflowNode
+}
+
+// Empty reports whether the graphs are equal.
+func (d *WorkflowGraphDiff) Empty() bool {
+	return len(d.Create) == 0 && len(d.Update) == 0 && len(d.Delete) == 0 &&
+		len(d.Link) == 0 && len(d.Unlink) == 0
+}
+
+// DiffWorkflowGraphs compares the current graph, usually read by GetWorkflowGraph,
+// with the desired one.
+func DiffWorkflowGraphs(current *WorkflowGraph, desired *WorkflowGraph) *WorkflowGraphDiff {
+	diff := &WorkflowGraphDiff{
+		current: map[string]*WorkflowNode{},
+	}
+
+	for _, node := range current.Nodes {
+		diff.current[node.Identifier] = node
+	}
+
+	wanted := map[string]bool{}
+	for _, node := range desired.Nodes {
+		wanted[node.Identifier] = true
+
+		existing, ok := diff.current[node.Identifier]
+		if !ok {
+			diff.Create = append(diff.Create, node)
+			continue
+		}
+
+		if !existing.sameSettings(node) {
+			updated := *node
+			updated.ID = existing.ID
+			diff.Update = append(diff.Update, &updated)
+		}
+	}
+
+	for _, node := range current.Nodes {
+		if !wanted[node.Identifier] {
+			diff.Delete = append(diff.Delete, node)
+		}
+	}
+
+	currentEdges := map[WorkflowEdge]bool{}
+	for _, edge := range current.Edges() {
+		currentEdges[edge] = true
+	}
+
+	desiredEdges := map[WorkflowEdge]bool{}
+	for _, edge := range desired.Edges() {
+		desiredEdges[edge] = true
+		if !currentEdges[edge] {
+			diff.Link = append(diff.Link, edge)
+		}
+	}
+
+	for _, edge := range current.Edges() {
+		// Edges of deleted nodes go away with them.
+		if !desiredEdges[edge] && wanted[edge.Parent] && wanted[edge.Child] {
+			diff.Unlink = append(diff.Unlink, edge)
+		}
+	}
+
+	return diff
+}
+
+func (n *WorkflowNode) sameSettings(other *WorkflowNode) bool {
+	if (n.Approval == nil) != (other.Approval == nil) {
+		return false
+	}
+
+	if n.Approval != nil {
+		if *n.Approval != *other.Approval {
+			return false
+		}
+	} else if n.UnifiedJobTemplate != other.UnifiedJobTemplate {
+		return false
+	}
+
+	return n.AllParentsMustConverge == other.AllParentsMustConverge &&
+		n.Inventory == other.Inventory &&
+		n.Limit == other.Limit &&
+		n.ScmBranch == other.ScmBranch &&
+		n.JobType == other.JobType &&
+		n.JobTags == other.JobTags &&
+		n.SkipTags == other.SkipTags &&
+		equalBoolPtr(n.DiffMode, other.DiffMode) &&
+		equalIntPtr(n.Verbosity, other.Verbosity) &&
+		equalJSON(n.ExtraData, other.ExtraData)
+}
+
+func equalBoolPtr(a *bool, b *bool) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+func equalIntPtr(a *int, b *int) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+// equalJSON compares maps the way awx stores them, so 1 and 1.0 are equal.
+func equalJSON(a map[string]interface{}, b map[string]interface{}) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+
+	renderedA, errA := json.Marshal(a)
+	renderedB, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+
+	var normalA, normalB interface{}
+	if json.Unmarshal(renderedA, &normalA) != nil || json.Unmarshal(renderedB, &normalB) != nil {
+		return false
+	}
+
+	renderedA, _ = json.Marshal(normalA)
+	renderedB, _ = json.Marshal(normalB)
+
+	return bytes.Equal(renderedA, renderedB)
+}
+
+func sortEdges(edges []WorkflowEdge) {
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Parent != edges[j].Parent {
+			return edges[i].Parent < edges[j].Parent
+		}
+		if edges[i].Kind != edges[j].Kind {
+			return edges[i].Kind < edges[j].Kind
+		}
+		return edges[i].Child < edges[j].Child
+	})
+}
+
+// payload renders the node settings, empty prompts are sent as null to clear them.
+func (n *WorkflowNode) payload() map[string]interface{} {
+	nullable := func(value string) interface{} {
+		if value == "" {
+			return nil
+		}
+		return value
+	}
+
+	payload := map[string]interface{}{
+		"identifier":                n.Identifier,
+		"all_parents_must_converge": n.AllParentsMustConverge,
+		"extra_data":                n.ExtraData,
+		"inventory":                 nil,
+		"limit":                     nullable(n.Limit),
+		"scm_branch":                nullable(n.ScmBranch),
+		"job_type":                  nullable(n.JobType),
+		"job_tags":                  nullable(n.JobTags),
+		"skip_tags":                 nullable(n.SkipTags),
+		"diff_mode":                 n.DiffMode,
+		"verbosity":                 n.Verbosity,
+	}
+
+	if n.ExtraData == nil {
+		payload["extra_data"] = map[string]interface{}{}
+	}
+	if n.Inventory != 0 {
+		payload["inventory"] = n.Inventory
+	}
+	if n.Approval == nil {
+		payload["unified_job_template"] = n.UnifiedJobTemplate
+	}
+
+	return payload
+}
+
+// GetWorkflowGraph reads the nodes of a workflow job template into a graph.
+func (wt *WorkflowJobTemplateService) GetWorkflowGraph(ctx context.Context, id int) (*WorkflowGraph, error) {
+	nodes, err := wt.ListAllWorkflowNodes(ctx, id, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers := map[int]string{}
+	for _, node := range nodes {
+		identifiers[node.ID] = node.Identifier
+		if node.Identifier == "" {
+			identifiers[node.ID] = strconv.Itoa(node.ID)
+		}
+	}
+
+	graph := NewWorkflowGraph()
+	for _, node := range nodes {
+		graphNode := &WorkflowNode{
+			Identifier:             identifiers[node.ID],
+			ID:                     node.ID,
+			UnifiedJobTemplate:     node.UnifiedJobTemplate,
+			AllParentsMustConverge: node.AllParentsMustConverge,
+			ExtraData:              node.ExtraData,
+			Inventory:              node.Inventory,
+			Limit:                  node.Limit,
+			ScmBranch:              node.ScmBranch,
+			JobType:                node.JobType,
+			JobTags:                node.JobTags,
+			SkipTags:               node.SkipTags,
+			DiffMode:               node.DiffMode,
+			Verbosity:              node.Verbosity,
+		}
+
+		if node.SummaryFields != nil && node.SummaryFields.UnifiedJobTemplate != nil {
+			template := node.SummaryFields.UnifiedJobTemplate
+			if template.UnifiedJobType == "workflow_approval" {
+				graphNode.Approval = &WorkflowApprovalSpec{
+					Name:        template.Name,
+					Description: template.Description,
+					Timeout:     template.Timeout,
+				}
+			}
+		}
+
+		for _, child := range node.SuccessNodes {
+			graphNode.SuccessNodes = append(graphNode.SuccessNodes, identifiers[child])
+		}
+		for _, child := range node.FailureNodes {
+			graphNode.FailureNodes = append(graphNode.FailureNodes, identifiers[child])
+		}
+		for _, child := range node.AlwaysNodes {
+			graphNode.AlwaysNodes = append(graphNode.AlwaysNodes, identifiers[child])
+		}
+
+		graph.Nodes = append(graph.Nodes, graphNode)
+	}
+
+	return graph, nil
+}
+
+// PlanWorkflowGraph compares the nodes of a workflow job template with the desired graph.
+func (wt *WorkflowJobTemplateService) PlanWorkflowGraph(ctx context.Context, id int, desired *WorkflowGraph) (*WorkflowGraphDiff, error) {
+	if err := desired.Validate(); err != nil {
+		return nil, err
+	}
+
+	current, err := wt.GetWorkflowGraph(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffWorkflowGraphs(current, desired), nil
+}
+
+// ApplyWorkflowGraph converges the nodes of a workflow job template to the desired graph
+// and returns the applied changes.
+func (wt *WorkflowJobTemplateService) ApplyWorkflowGraph(ctx context.Context, id int, desired *WorkflowGraph) (*WorkflowGraphDiff, error) {
+	diff, err := wt.PlanWorkflowGraph(ctx, id, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff, wt.ApplyWorkflowGraphDiff(ctx, id, diff)
+}
+
+// WorkflowGraphApplyError is returned by ApplyWorkflowGraphDiff when a change fails.
+// Applied holds the changes made before the failure, created nodes with their new IDs.
+type WorkflowGraphApplyError struct {
+	Applied *WorkflowGraphDiff
+	Err     error
+}
+
+func (e *WorkflowGraphApplyError) Error() string {
+	applied := e.Applied
+	return fmt.Sprintf("apply workflow graph: %v (applied %d deleted, %d unlinked, %d created, %d updated, %d linked)",
+		e.Err, len(applied.Delete), len(applied.Unlink), len(applied.Create), len(applied.Update), len(applied.Link))
+}
+
+func (e *WorkflowGraphApplyError) Unwrap() error {
+	return e.Err
+}
+
+// ApplyWorkflowGraphDiff applies the changes planned by PlanWorkflowGraph.
+// Nodes are deleted and unlinked first, then created, updated and linked.
+// On failure it returns a *WorkflowGraphApplyError telling how far it got.
+func (wt *WorkflowJobTemplateService) ApplyWorkflowGraphDiff(ctx context.Context, id int, diff *WorkflowGraphDiff) error {
+	ids := map[string]int{}
+	for identifier, node := range diff.current {
+		ids[identifier] = node.ID
+	}
+
+	applied := &WorkflowGraphDiff{current: diff.current}
+	fail := func(err error) error {
+		return &WorkflowGraphApplyError{Applied: applied, Err: err}
+	}
+
+	for _, node := range diff.Delete {
+		if err := wt.DeleteWorkflowNode(ctx, node.ID); err != nil {
+			return fail(fmt.Errorf("delete node %q: %w", node.Identifier, err))
+		}
+		applied.Delete = append(applied.Delete, node)
+	}
+
+	for _, edge := range diff.Unlink {
+		if err := wt.UnlinkWorkflowNodes(ctx, ids[edge.Parent], ids[edge.Child], edge.Kind); err != nil {
+			return fail(fmt.Errorf("unlink %q -> %q: %w", edge.Parent, edge.Child, err))
+		}
+		applied.Unlink = append(applied.Unlink, edge)
+	}
+
+	for _, node := range diff.Create {
+		created, err := wt.CreateWorkflowNode(ctx, id, node.payload())
+		if err != nil {
+			return fail(fmt.Errorf("create node %q: %w", node.Identifier, err))
+		}
+
+		if node.Approval != nil {
+			approval := node.Approval
+			if _, err := wt.createApprovalTemplateOrDelete(ctx, created.ID, approval.Name, approval.Description, approval.Timeout); err != nil {
+				return fail(fmt.Errorf("create approval of node %q: %w", node.Identifier, err))
+			}
+		}
+
+		ids[node.Identifier] = created.ID
+		createdNode := *node
+		createdNode.ID = created.ID
+		applied.Create = append(applied.Create, &createdNode)
+	}
+
+	for _, node := range diff.Update {
+		if _, err := wt.UpdateWorkflowNode(ctx, node.ID, node.payload()); err != nil {
+			return fail(fmt.Errorf("update node %q: %w", node.Identifier, err))
+		}
+
+		if node.Approval != nil {
+			if err := wt.applyApproval(ctx, diff.current[node.Identifier], node); err != nil {
+				return fail(fmt.Errorf("update approval of node %q: %w", node.Identifier, err))
+			}
+		}
+		applied.Update = append(applied.Update, node)
+	}
+
+	for _, edge := range diff.Link {
+		if err := wt.LinkWorkflowNodes(ctx, ids[edge.Parent], ids[edge.Child], edge.Kind); err != nil {
+			return fail(fmt.Errorf("link %q -> %q: %w", edge.Parent, edge.Child, err))
+		}
+		applied.Link = append(applied.Link, edge)
+	}
+
+	return nil
+}
+
+// applyApproval updates the approval template in place or turns the node into an approval.
+func (wt *WorkflowJobTemplateService) applyApproval(ctx context.Context, current *WorkflowNode, desired *WorkflowNode) error {
+	if current.Approval == nil {
+		_, err := wt.CreateApprovalTemplate(ctx, desired.ID, desired.Approval.Name, desired.Approval.Description, desired.Approval.Timeout)
+		return err
+	}
+
+	if *current.Approval == *desired.Approval {
+		return nil
+	}
+
+	_, err := wt.UpdateApprovalTemplate(ctx, current.UnifiedJobTemplate, map[string]interface{}{
+		"name":        desired.Approval.Name,
+		"description": desired.Approval.Description,
+		"timeout":     desired.Approval.Timeout,
+	})
+
+	return err
+}
@@ -0,0 +1,324 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestWorkflowGraphValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph func() *WorkflowGraph
+		// wantErr lists the substrings of the error, none means the graph is valid.
+		wantErr []string
+	}{
+		{
+			name: "chain",
+			graph: func() *WorkflowGraph {
+				g := NewWorkflowGraph()
+				g.AddNode("sync", 1)
+				g.AddApprovalNode("approve", "Approve", 60)
+				g.AddNode("deploy", 2)
+				return g.OnSuccess("sync", "approve").OnSuccess("approve", "deploy")
+			},
+		},
+		{
+			name: "diamond is not a cycle",
+			graph: func() *WorkflowGraph {
+				g := NewWorkflowGraph()
+				g.AddNode("a", 1)
+				g.AddNode("b", 1)
+				g.AddNode("c", 1)
+				g.AddNode("d", 1)
+				return g.OnSuccess("a", "b").OnFailure("a", "c").Always("b", "d").Always("c", "d")
+			},
+		},
+		{
+			name: "cycle",
+			graph: func() *WorkflowGraph {
+				g := NewWorkflowGraph()
+				g.AddNode("a", 1)
+				g.AddNode("b", 1)
+				g.AddNode("c", 1)
+				return g.OnSuccess("a", "b").OnSuccess("b", "c").OnFailure("c", "b")
+			},
+			wantErr: []string{"cycle b -> c -> b"},
+		},
+		{
+			name: "self loop",
+			graph: func() *WorkflowGraph {
+				g := NewWorkflowGraph()
+				g.AddNode("a", 1)
+				return g.Always("a", "a")
+			},
+			wantErr: []string{"cycle a -> a"},
+		},
+		{
+			name: "unknown child",
+			graph: func() *WorkflowGraph {
+				g := NewWorkflowGraph()
+				g.AddNode("a", 1)
+				return g.OnSuccess("a", "missing")
+			},
+			wantErr: []string{`edge "a" -> "missing" points to unknown node`},
+		},
+		{
+			name: "unknown parent",
+			graph: func() *WorkflowGraph {
+				g := NewWorkflowGraph()
+				g.AddNode("a", 1)
+				return g.OnSuccess("missing", "a")
+			},
+			wantErr: []string{`node "missing" runs nothing`},
+		},
+		{
+			name: "duplicate and empty nodes",
+			graph: func() *WorkflowGraph {
+				g := NewWorkflowGraph()
+				g.AddNode("a", 1)
+				g.AddNode("a", 2)
+				g.AddNode("", 3)
+				return g
+			},
+			wantErr: []string{`duplicate node "a"`, "node without identifier"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.graph().Validate()
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("Validate() = nil, want %q", tt.wantErr)
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Validate() = %q, want it to contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWorkflowGraphEdgesAndRoots(t *testing.T) {
+	g := NewWorkflowGraph()
+	g.AddNode("b", 1)
+	g.AddNode("a", 1)
+	g.AddNode("c", 1)
+	g.Always("b", "c").OnSuccess("a", "c").OnFailure("a", "b").OnSuccess("a", "b").OnSuccess("a", "b")
+
+	wantEdges := []WorkflowEdge{
+		{Parent: "a", Child: "b", Kind: WorkflowEdgeFailure},
+		{Parent: "a", Child: "b", Kind: WorkflowEdgeSuccess},
+		{Parent: "a", Child: "c", Kind: WorkflowEdgeSuccess},
+		{Parent: "b", Child: "c", Kind: WorkflowEdgeAlways},
+	}
+	if edges := g.Edges(); !reflect.DeepEqual(edges, wantEdges) {
+		t.Errorf("Edges() = %v, want %v", edges, wantEdges)
+	}
+
+	roots := g.Roots()
+	if len(roots) != 1 || roots[0].Identifier != "a" {
+		t.Errorf("Roots() = %v, want [a]", identifiers(roots))
+	}
+}
+
+func TestDiffWorkflowGraphs(t *testing.T) {
+	current := func() *WorkflowGraph {
+		g := NewWorkflowGraph()
+		g.AddNode("sync", 1).ID = 10
+		g.AddNode("deploy", 2).ID = 11
+		g.AddNode("notify", 3).ID = 12
+		return g.OnSuccess("sync", "deploy").Always("deploy", "notify")
+	}
+
+	tests := []struct {
+		name       string
+		desired    func() *WorkflowGraph
+		wantCreate []string
+		wantUpdate []string
+		wantDelete []string
+		wantLink   []WorkflowEdge
+		wantUnlink []WorkflowEdge
+	}{
+		{
+			name:    "same graph",
+			desired: current,
+		},
+		{
+			name: "changed settings",
+			desired: func() *WorkflowGraph {
+				g := current()
+				node, _ := g.Node("deploy")
+				node.Limit = "web"
+				return g
+			},
+			wantUpdate: []string{"deploy"},
+		},
+		{
+			name: "new node and edge",
+			desired: func() *WorkflowGraph {
+				g := current()
+				g.AddApprovalNode("approve", "Approve", 0)
+				return g.OnSuccess("sync", "approve")
+			},
+			wantCreate: []string{"approve"},
+			wantLink:   []WorkflowEdge{{Parent: "sync", Child: "approve", Kind: WorkflowEdgeSuccess}},
+		},
+		{
+			name: "deleted node keeps its edges",
+			desired: func() *WorkflowGraph {
+				g := NewWorkflowGraph()
+				g.AddNode("sync", 1)
+				g.AddNode("deploy", 2)
+				return g.OnSuccess("sync", "deploy")
+			},
+			wantDelete: []string{"notify"},
+		},
+		{
+			name: "changed edge kind",
+			desired: func() *WorkflowGraph {
+				g := NewWorkflowGraph()
+				g.AddNode("sync", 1)
+				g.AddNode("deploy", 2)
+				g.AddNode("notify", 3)
+				return g.OnSuccess("sync", "deploy").OnFailure("deploy", "notify").OnSuccess("deploy", "notify")
+			},
+			wantLink: []WorkflowEdge{
+				{Parent: "deploy", Child: "notify", Kind: WorkflowEdgeFailure},
+				{Parent: "deploy", Child: "notify", Kind: WorkflowEdgeSuccess},
+			},
+			wantUnlink: []WorkflowEdge{{Parent: "deploy", Child: "notify", Kind: WorkflowEdgeAlways}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := DiffWorkflowGraphs(current(), tt.desired())
+
+			if got := identifiers(diff.Create); !equalStrings(got, tt.wantCreate) {
+				t.Errorf("Create = %v, want %v", got, tt.wantCreate)
+			}
+			if got := identifiers(diff.Update); !equalStrings(got, tt.wantUpdate) {
+				t.Errorf("Update = %v, want %v", got, tt.wantUpdate)
+			}
+			if got := identifiers(diff.Delete); !equalStrings(got, tt.wantDelete) {
+				t.Errorf("Delete = %v, want %v", got, tt.wantDelete)
+			}
+			if !equalEdges(diff.Link, tt.wantLink) {
+				t.Errorf("Link = %v, want %v", diff.Link, tt.wantLink)
+			}
+			if !equalEdges(diff.Unlink, tt.wantUnlink) {
+				t.Errorf("Unlink = %v, want %v", diff.Unlink, tt.wantUnlink)
+			}
+
+			wantEmpty := len(tt.wantCreate)+len(tt.wantUpdate)+len(tt.wantDelete)+len(tt.wantLink)+len(tt.wantUnlink) == 0
+			if diff.Empty() != wantEmpty {
+				t.Errorf("Empty() = %v, want %v", diff.Empty(), wantEmpty)
+			}
+		})
+	}
+}
+
+func TestDiffWorkflowGraphsUpdateKeepsID(t *testing.T) {
+	current := NewWorkflowGraph()
+	current.AddNode("deploy", 2).ID = 11
+
+	desired := NewWorkflowGraph()
+	desired.AddNode("deploy", 3)
+
+	diff := DiffWorkflowGraphs(current, desired)
+	if len(diff.Update) != 1 || diff.Update[0].ID != 11 || diff.Update[0].UnifiedJobTemplate != 3 {
+		t.Fatalf("Update = %+v, want deploy with ID 11 and template 3", diff.Update)
+	}
+	if node, _ := desired.Node("deploy"); node.ID != 0 {
+		t.Errorf("desired node ID = %d, the desired graph must not change", node.ID)
+	}
+}
+
+func TestApplyWorkflowGraphDiffDeletesOrphanApproval(t *testing.T) {
+	var mu sync.Mutex
+	requests := make([]string, 0)
+	nextID := 100
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/create_approval_template/"):
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"detail": "bad timeout"}`))
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/workflow_nodes/"):
+			nextID++
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"id": %d}`, nextID)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	service := &WorkflowJobTemplateService{Requester: &Requester{Base: server.URL, Client: server.Client()}}
+
+	desired := NewWorkflowGraph()
+	desired.AddNode("sync", 1)
+	desired.AddApprovalNode("approve", "Approve", -1)
+	desired.OnSuccess("sync", "approve")
+
+	err := service.ApplyWorkflowGraphDiff(context.Background(), 7, DiffWorkflowGraphs(NewWorkflowGraph(), desired))
+
+	var applyErr *WorkflowGraphApplyError
+	if !errors.As(err, &applyErr) {
+		t.Fatalf("ApplyWorkflowGraphDiff() = %v, want *WorkflowGraphApplyError", err)
+	}
+	if !strings.Contains(err.Error(), "bad timeout") {
+		t.Errorf("ApplyWorkflowGraphDiff() = %q, want the approval error", err)
+	}
+	if got := identifiers(applyErr.Applied.Create); !equalStrings(got, []string{"sync"}) || applyErr.Applied.Create[0].ID != 101 {
+		t.Errorf("Applied.Create = %v, want [sync] with ID 101", got)
+	}
+	if len(applyErr.Applied.Link) != 0 {
+		t.Errorf("Applied.Link = %v, want none", applyErr.Applied.Link)
+	}
+
+	wantRequests := []string{
+		"POST /api/v2/workflow_job_templates/7/workflow_nodes/",
+		"POST /api/v2/workflow_job_templates/7/workflow_nodes/",
+		"POST /api/v2/workflow_job_template_nodes/102/create_approval_template/",
+		"DELETE /api/v2/workflow_job_template_nodes/102/",
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("requests = %v, want %v", requests, wantRequests)
+	}
+}
+
+func identifiers(nodes []*WorkflowNode) []string {
+	result := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		result = append(result, node.Identifier)
+	}
+
+	return result
+}
+
+func equalStrings(a []string, b []string) bool {
+	return len(a) == len(b) && (len(a) == 0 || reflect.DeepEqual(a, b))
+}
+
+func equalEdges(a []WorkflowEdge, b []WorkflowEdge) bool {
+	return len(a) == len(b) && (len(a) == 0 || reflect.DeepEqual(a, b))
+}
@@ -0,0 +1,141 @@
+package awx
+
+import (
+	"context"
+	"fmt"
+)
+
+// WorkflowJobService implements awx workflow job apis.
+type WorkflowJobService struct {
+	Requester *Requester
+}
+
+// ListWorkflowJobs shows a list of workflow jobs.
+func (w *WorkflowJobService) ListWorkflowJobs(ctx context.Context, params ListParams) (*ListWorkflowJobs, error) {
+	result := ListWorkflowJobs{}
+	endpoint := "/api/v2/workflow_jobs/"
+
+	_, err := w.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetWorkflowJob shows the details of a workflow job.
+func (w *WorkflowJobService) GetWorkflowJob(ctx context.Context, id int) (*WorkflowJob, error) {
+	result := WorkflowJob{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_jobs/%d/", id)
+
+	_, err := w.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CancelWorkflowJob cancels a workflow job along with its running nodes.
+func (w *WorkflowJobService) CancelWorkflowJob(ctx context.Context, id int) (*CancelJobResponse, error) {
+	result := CancelJobResponse{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_jobs/%d/cancel/", id)
+
+	_, err := w.Requester.Post(ctx, endpoint, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RelaunchWorkflowJob relaunch a workflow job and returns the new one.
+func (w *WorkflowJobService) RelaunchWorkflowJob(ctx context.Context, id int) (*WorkflowJob, error) {
+	result := WorkflowJob{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_jobs/%d/relaunch/", id)
+
+	_, err := w.Requester.Post(ctx, endpoint, map[string]interface{}{}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Wait polls the workflow job until it reaches one of the finished statuses.
+// A *JobFailedError is returned along with the workflow job when it is failed, error or canceled,
+// use GetFailedNodes to find the failed step.
+func (w *WorkflowJobService) Wait(ctx context.Context, id int, opts *WaitOptions) (*WorkflowJob, error) {
+	return waitFor(ctx, id, opts, func(ctx context.Context) (*WorkflowJob, jobState, error) {
+		job, err := w.GetWorkflowJob(ctx, id)
+		if err != nil {
+			return nil, jobState{}, err
+		}
+
+		return job, jobState{
+			Type:            "workflow_job",
+			Status:          job.Status,
+			JobExplanation:  job.JobExplanation,
+			ResultTraceback: job.ResultTraceback,
+		}, nil
+	})
+}
+
+// ListWorkflowNodes shows a list of nodes of a workflow job with the status of their jobs.
+func (w *WorkflowJobService) ListWorkflowNodes(ctx context.Context, id int, params ListParams) (*ListWorkflowJobNodes, error) {
+	result := ListWorkflowJobNodes{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_jobs/%d/workflow_nodes/", id)
+
+	_, err := w.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAllWorkflowNodes shows a list of nodes of a workflow job from every page.
+func (w *WorkflowJobService) ListAllWorkflowNodes(ctx context.Context, id int, params ListParams) ([]*WorkflowJobNode, error) {
+	endpoint := fmt.Sprintf("/api/v2/workflow_jobs/%d/workflow_nodes/", id)
+	return newIterator[*WorkflowJobNode](w.Requester, endpoint, params, nil).All(ctx)
+}
+
+// GetFailedNodes shows the nodes of a workflow job which jobs are failed, error or canceled.
+func (w *WorkflowJobService) GetFailedNodes(ctx context.Context, id int) ([]*WorkflowJobNode, error) {
+	nodes, err := w.ListAllWorkflowNodes(ctx, id, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*WorkflowJobNode, 0)
+	for _, node := range nodes {
+		if node.Failed() {
+			result = append(result, node)
+		}
+	}
+
+	return result, nil
+}
+
+// JobStatus returns the status of the job spawned by the node, empty when it has not run.
+func (n *WorkflowJobNode) JobStatus() string {
+	if n.SummaryFields == nil || n.SummaryFields.Job == nil {
+		return ""
+	}
+
+	return n.SummaryFields.Job.Status
+}
+
+// Failed reports whether the job spawned by the node failed.
+func (n *WorkflowJobNode) Failed() bool {
+	if n.SummaryFields == nil || n.SummaryFields.Job == nil {
+		return false
+	}
+
+	switch n.SummaryFields.Job.Status {
+	case JobStatusFailed, JobStatusError, JobStatusCanceled:
+		return true
+	}
+
+	return n.SummaryFields.Job.Failed
+}
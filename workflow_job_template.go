@@ -0,0 +1,398 @@
+package awx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// WorkflowJobTemplateService implements awx workflow job template apis.
+type WorkflowJobTemplateService struct {
+	Requester *Requester
+}
+
+// ListWorkflowJobTemplates shows a list of workflow job templates.
+func (wt *WorkflowJobTemplateService) ListWorkflowJobTemplates(ctx context.Context, params ListParams) (*ListWorkflowJobTemplates, error) {
+	result := ListWorkflowJobTemplates{}
+	endpoint := "/api/v2/workflow_job_templates/"
+
+	_, err := wt.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// WorkflowJobTemplatesIter returns an iterator over all workflow job templates, following pagination.
+func (wt *WorkflowJobTemplateService) WorkflowJobTemplatesIter(params ListParams, opts *ListOptions) *Iterator[*WorkflowJobTemplate] {
+	return newIterator[*WorkflowJobTemplate](wt.Requester, "/api/v2/workflow_job_templates/", params, opts)
+}
+
+// ListAllWorkflowJobTemplates shows a list of workflow job templates from every page.
+func (wt *WorkflowJobTemplateService) ListAllWorkflowJobTemplates(ctx context.Context, params ListParams, opts *ListOptions) ([]*WorkflowJobTemplate, error) {
+	return wt.WorkflowJobTemplatesIter(params, opts).All(ctx)
+}
+
+// GetWorkflowJobTemplate retrives the workflow job template information from its ID.
+func (wt *WorkflowJobTemplateService) GetWorkflowJobTemplate(ctx context.Context, id int) (*WorkflowJobTemplate, error) {
+	result := WorkflowJobTemplate{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_templates/%d/", id)
+
+	_, err := wt.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateWorkflowJobTemplate creates a workflow job template
+//
+//	name TEXT *REQUIRED
+//	description TEXT
+//	extra_vars JSON/YAML
+//	organization ID
+//	survey_enabled BOOLEAN
+//	allow_simultaneous BOOLEAN
+//	ask_variables_on_launch BOOLEAN
+//	inventory ID
+//	limit TEXT
+//	scm_branch TEXT
+//	ask_inventory_on_launch BOOLEAN
+//	ask_scm_branch_on_launch BOOLEAN
+//	ask_limit_on_launch BOOLEAN
+//	ask_labels_on_launch BOOLEAN
+//	ask_skip_tags_on_launch BOOLEAN
+//	ask_tags_on_launch BOOLEAN
+//	skip_tags TEXT
+//	job_tags TEXT
+//	webhook_service {,github,gitlab}
+//	webhook_credential ID
+func (wt *WorkflowJobTemplateService) CreateWorkflowJobTemplate(ctx context.Context, data map[string]interface{}) (*WorkflowJobTemplate, error) {
+	result := WorkflowJobTemplate{}
+	endpoint := "/api/v2/workflow_job_templates/"
+
+	validate, status := ValidateParams(data, []string{"name"})
+	if !status {
+		return nil, fmt.Errorf("mandatory input arguments are absent: %s", validate)
+	}
+
+	_, err := wt.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateWorkflowJobTemplate updates a workflow job template.
+func (wt *WorkflowJobTemplateService) UpdateWorkflowJobTemplate(ctx context.Context, id int, data map[string]interface{}) (*WorkflowJobTemplate, error) {
+	result := WorkflowJobTemplate{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_templates/%d", id)
+
+	_, err := wt.Requester.Patch(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteWorkflowJobTemplate deletes a workflow job template.
+func (wt *WorkflowJobTemplateService) DeleteWorkflowJobTemplate(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_templates/%d", id)
+
+	_, err := wt.Requester.Delete(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetLaunchInfo shows which fields the workflow job template prompts for on launch
+// and what is needed to start it.
+func (wt *WorkflowJobTemplateService) GetLaunchInfo(ctx context.Context, id int) (*LaunchInfo, error) {
+	result := LaunchInfo{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_templates/%d/launch/", id)
+
+	_, err := wt.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Launch lauchs a workflow job with the workflow job template
+//
+//	extra_vars JSON/YAML
+//	inventory ID
+//	limit TEXT
+//	scm_branch TEXT
+//	labels [ID, ID, ...]
+//	job_tags TEXT
+//	skip_tags TEXT
+func (wt *WorkflowJobTemplateService) Launch(ctx context.Context, id int, data map[string]interface{}) (*WorkflowJobLaunch, error) {
+	result := WorkflowJobLaunch{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_templates/%d/launch/", id)
+
+	_, err := wt.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	// in case invalid workflow job id return
+	if result.WorkflowJob == 0 {
+		return nil, errors.New("invalid workflow job id 0")
+	}
+
+	return &result, nil
+}
+
+// LaunchWithRequest validates the request against the launch info of the workflow job template
+// and launches a workflow job. A *LaunchValidationError is returned before anything
+// is started when the template would ignore or reject some of the fields
+// or the extra vars do not answer its survey.
+func (wt *WorkflowJobTemplateService) LaunchWithRequest(ctx context.Context, id int, request *LaunchRequest) (*WorkflowJobLaunch, error) {
+	info, err := wt.GetLaunchInfo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec *SurveySpec
+	if info.SurveyEnabled {
+		spec, err = wt.GetSurveySpec(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := request.ValidateWithSurvey(info, spec); err != nil {
+		return nil, err
+	}
+
+	data, err := request.Payload()
+	if err != nil {
+		return nil, err
+	}
+
+	return wt.Launch(ctx, id, data)
+}
+
+// GetSurveySpec shows the survey spec of a workflow job template.
+func (wt *WorkflowJobTemplateService) GetSurveySpec(ctx context.Context, id int) (*SurveySpec, error) {
+	result := SurveySpec{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_templates/%d/survey_spec/", id)
+
+	_, err := wt.Requester.Get(ctx, endpoint, &result, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SetSurveySpec replaces the survey spec of a workflow job template.
+// The survey is used on launch only while survey_enabled is set on the template.
+func (wt *WorkflowJobTemplateService) SetSurveySpec(ctx context.Context, id int, spec *SurveySpec) error {
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_templates/%d/survey_spec/", id)
+
+	_, err := wt.Requester.Post(ctx, endpoint, spec, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteSurveySpec deletes the survey spec of a workflow job template.
+func (wt *WorkflowJobTemplateService) DeleteSurveySpec(ctx context.Context, id int) error {
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_templates/%d/survey_spec/", id)
+
+	_, err := wt.Requester.Delete(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Enum of workflow node edge kinds, named after the node endpoints.
+const (
+	WorkflowEdgeSuccess = "success_nodes"
+	WorkflowEdgeFailure = "failure_nodes"
+	WorkflowEdgeAlways  = "always_nodes"
+)
+
+// ListWorkflowNodes shows a list of nodes of a workflow job template.
+func (wt *WorkflowJobTemplateService) ListWorkflowNodes(ctx context.Context, id int, params ListParams) (*ListWorkflowJobTemplateNodes, error) {
+	result := ListWorkflowJobTemplateNodes{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_templates/%d/workflow_nodes/", id)
+
+	_, err := wt.Requester.Get(ctx, endpoint, &result, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAllWorkflowNodes shows a list of nodes of a workflow job template from every page.
+func (wt *WorkflowJobTemplateService) ListAllWorkflowNodes(ctx context.Context, id int, params ListParams) ([]*WorkflowJobTemplateNode, error) {
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_templates/%d/workflow_nodes/", id)
+	return newIterator[*WorkflowJobTemplateNode](wt.Requester, endpoint, params, nil).All(ctx)
+}
+
+// CreateWorkflowNode creates a node in a workflow job template
+//
+//	unified_job_template ID
+//	identifier TEXT
+//	all_parents_must_converge BOOLEAN
+//	extra_data JSON
+//	inventory ID
+//	scm_branch TEXT
+//	job_type {run,check}
+//	job_tags TEXT
+//	skip_tags TEXT
+//	limit TEXT
+//	diff_mode BOOLEAN
+//	verbosity {0,1,2,3,4,5}
+func (wt *WorkflowJobTemplateService) CreateWorkflowNode(ctx context.Context, id int, data map[string]interface{}) (*WorkflowJobTemplateNode, error) {
+	result := WorkflowJobTemplateNode{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_templates/%d/workflow_nodes/", id)
+
+	_, err := wt.Requester.Post(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateWorkflowNode updates a workflow job template node.
+func (wt *WorkflowJobTemplateService) UpdateWorkflowNode(ctx context.Context, nodeID int, data map[string]interface{}) (*WorkflowJobTemplateNode, error) {
+	result := WorkflowJobTemplateNode{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d", nodeID)
+
+	_, err := wt.Requester.Patch(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteWorkflowNode deletes a workflow job template node along with its edges.
+func (wt *WorkflowJobTemplateService) DeleteWorkflowNode(ctx context.Context, nodeID int) error {
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d", nodeID)
+
+	_, err := wt.Requester.Delete(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// LinkWorkflowNodes runs the child node after the parent one,
+// kind is one of WorkflowEdgeSuccess, WorkflowEdgeFailure or WorkflowEdgeAlways.
+func (wt *WorkflowJobTemplateService) LinkWorkflowNodes(ctx context.Context, parentID int, childID int, kind string) error {
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/%s/", parentID, kind)
+
+	payload := map[string]interface{}{
+		"id": childID,
+	}
+
+	_, err := wt.Requester.Post(ctx, endpoint, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UnlinkWorkflowNodes removes the edge between the parent and the child nodes.
+func (wt *WorkflowJobTemplateService) UnlinkWorkflowNodes(ctx context.Context, parentID int, childID int, kind string) error {
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/%s/", parentID, kind)
+
+	payload := map[string]interface{}{
+		"id":           childID,
+		"disassociate": true,
+	}
+
+	_, err := wt.Requester.Post(ctx, endpoint, payload, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateApprovalTemplate turns the node into an approval gate.
+// Timeout is in seconds, zero means the approval never expires.
+func (wt *WorkflowJobTemplateService) CreateApprovalTemplate(ctx context.Context, nodeID int, name string, description string, timeout int) (*WorkflowApprovalTemplate, error) {
+	result := WorkflowApprovalTemplate{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_job_template_nodes/%d/create_approval_template/", nodeID)
+
+	payload := map[string]interface{}{
+		"name":        name,
+		"description": description,
+		"timeout":     timeout,
+	}
+
+	_, err := wt.Requester.Post(ctx, endpoint, payload, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateApprovalTemplate updates an approval template.
+func (wt *WorkflowJobTemplateService) UpdateApprovalTemplate(ctx context.Context, id int, data map[string]interface{}) (*WorkflowApprovalTemplate, error) {
+	result := WorkflowApprovalTemplate{}
+	endpoint := fmt.Sprintf("/api/v2/workflow_approval_templates/%d", id)
+
+	_, err := wt.Requester.Patch(ctx, endpoint, data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// CreateApprovalNode creates a node of the workflow job template gating its children on an approval.
+// Timeout is in seconds, zero means the approval never expires.
+func (wt *WorkflowJobTemplateService) CreateApprovalNode(ctx context.Context, id int, identifier string, name string, description string, timeout int) (*WorkflowJobTemplateNode, error) {
+	node, err := wt.CreateWorkflowNode(ctx, id, map[string]interface{}{
+		"identifier": identifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := wt.createApprovalTemplateOrDelete(ctx, node.ID, name, description, timeout)
+	if err != nil {
+		return nil, err
+	}
+	node.UnifiedJobTemplate = template.ID
+
+	return node, nil
+}
+
+// createApprovalTemplateOrDelete creates the approval template of a new node and deletes
+// the node when that fails, awx refuses to launch a workflow with a node missing its template.
+func (wt *WorkflowJobTemplateService) createApprovalTemplateOrDelete(ctx context.Context, nodeID int, name string, description string, timeout int) (*WorkflowApprovalTemplate, error) {
+	template, err := wt.CreateApprovalTemplate(ctx, nodeID, name, description, timeout)
+	if err != nil {
+		if deleteErr := wt.DeleteWorkflowNode(ctx, nodeID); deleteErr != nil {
+			return nil, fmt.Errorf("create approval template: %w (deleting node %d: %v)", err, nodeID, deleteErr)
+		}
+
+		return nil, fmt.Errorf("create approval template: %w", err)
+	}
+
+	return template, nil
+}